@@ -45,12 +45,16 @@ import (
 type ChatOptions struct {
 	configFlags *genericclioptions.ConfigFlags
 
-	WorkspaceName string
-	Namespace     string
-	SystemPrompt  string
-	Temperature   float64
-	MaxTokens     int
-	TopP          float64
+	WorkspaceName  string
+	Namespace      string
+	SystemPrompt   string
+	Temperature    float64
+	MaxTokens      int
+	TopP           float64
+	RequestTimeout string
+	Raw            bool
+
+	requestTimeout time.Duration
 }
 
 // NewChatCmd creates the chat command
@@ -79,7 +83,10 @@ OpenAI-compatible APIs in interactive mode.`,
   kubectl kaito chat --workspace-name my-llama --system-prompt "You are a helpful coding assistant"
 
   # Pipe input for non-interactive usage
-  echo "What is AI?" | kubectl kaito chat --workspace-name my-llama`,
+  echo "What is AI?" | kubectl kaito chat --workspace-name my-llama
+
+  # Send a hand-crafted OpenAI request body and print the raw JSON response
+  echo '{"model":"phi-3.5-mini-instruct","messages":[{"role":"user","content":"Hi"}]}' | kubectl kaito chat --workspace-name my-llama --raw`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := o.validate(); err != nil {
 				klog.Errorf("Validation failed: %v", err)
@@ -95,6 +102,8 @@ OpenAI-compatible APIs in interactive mode.`,
 	cmd.Flags().Float64Var(&o.Temperature, "temperature", 0.7, "Temperature for response generation (0.0-2.0)")
 	cmd.Flags().IntVar(&o.MaxTokens, "max-tokens", 1024, "Maximum tokens in response")
 	cmd.Flags().Float64Var(&o.TopP, "top-p", 0.9, "Top-p (nucleus sampling) parameter (0.0-1.0)")
+	cmd.Flags().StringVar(&o.RequestTimeout, "request-timeout", "30s", "Timeout for each inference request (e.g. 30s, 5m)")
+	cmd.Flags().BoolVar(&o.Raw, "raw", false, "Send each input line as the full JSON request body and print the raw JSON response")
 
 	if err := cmd.MarkFlagRequired("workspace-name"); err != nil {
 		klog.Errorf("Failed to mark workspace-name flag as required: %v", err)
@@ -118,6 +127,15 @@ func (o *ChatOptions) validate() error {
 	if o.MaxTokens <= 0 {
 		return fmt.Errorf("max-tokens must be greater than 0")
 	}
+	if o.Raw && o.SystemPrompt != "" {
+		return fmt.Errorf("--system-prompt cannot be used with --raw")
+	}
+
+	requestTimeout, err := parsePositiveDurationFlag("request-timeout", o.RequestTimeout)
+	if err != nil {
+		return err
+	}
+	o.requestTimeout = requestTimeout
 
 	klog.V(4).Info("Chat validation completed successfully")
 	return nil
@@ -127,14 +145,7 @@ func (o *ChatOptions) run() error {
 	klog.V(2).Infof("Starting chat with workspace: %s", o.WorkspaceName)
 
 	// Get namespace
-	if o.Namespace == "" {
-		if ns, _, err := o.configFlags.ToRawKubeConfigLoader().Namespace(); err == nil && ns != "" {
-			o.Namespace = ns
-		} else {
-			klog.V(4).Info("No namespace specified, using 'default'")
-			o.Namespace = "default"
-		}
-	}
+	o.Namespace = resolveNamespace(o.configFlags, o.Namespace)
 
 	// Get REST config
 	config, err := o.configFlags.ToRESTConfig()
@@ -332,30 +343,44 @@ func (o *ChatOptions) convertToString(value interface{}) string {
 }
 
 func (o *ChatOptions) startInteractiveSession(endpoint, modelName string) error {
+	// In raw mode stdout carries only the JSON responses, so session chatter goes to stderr
+	ui := io.Writer(os.Stdout)
+	if o.Raw {
+		ui = os.Stderr
+	}
+	return o.runChatLoop(os.Stdin, os.Stdout, ui, endpoint, modelName)
+}
+
+// runChatLoop reads input lines from in, writes model responses to out, and writes the
+// banner, prompts, and command output to ui
+func (o *ChatOptions) runChatLoop(in io.Reader, out, ui io.Writer, endpoint, modelName string) error {
 	klog.V(2).Info("Starting interactive chat session")
 
-	fmt.Printf("Connected to workspace: %s (model: %s)\n", o.WorkspaceName, modelName)
-	fmt.Println("Type /help for commands or /quit to exit.")
-	fmt.Println()
+	fmt.Fprintf(ui, "Connected to workspace: %s (model: %s)\n", o.WorkspaceName, modelName)
+	fmt.Fprintln(ui, "Type /help for commands or /quit to exit.")
+	if o.Raw {
+		fmt.Fprintln(ui, "Raw mode: each line is sent as the full JSON request body.")
+	}
+	fmt.Fprintln(ui)
 
-	scanner := bufio.NewScanner(os.Stdin)
+	scanner := bufio.NewScanner(in)
 
 	for {
-		fmt.Print(">>> ")
+		fmt.Fprint(ui, ">>> ")
 		if !scanner.Scan() {
 			if err := scanner.Err(); err != nil {
-				fmt.Println("\nChat session ended.")
-				return nil
+				klog.Errorf("Error reading input: %v", err)
+				return fmt.Errorf("error reading input: %w", err)
 			}
-			klog.Errorf("Error reading input: %v", scanner.Err())
-			return fmt.Errorf("error reading input: %w", scanner.Err())
+			fmt.Fprintln(ui, "\nChat session ended.")
+			return nil
 		}
 
 		input := strings.TrimSpace(scanner.Text())
 
 		// Handle commands
 		if strings.HasPrefix(input, "/") {
-			if o.handleCommand(input, modelName) {
+			if o.handleCommand(ui, input, modelName) {
 				return nil // Exit command
 			}
 			continue
@@ -367,18 +392,26 @@ func (o *ChatOptions) startInteractiveSession(endpoint, modelName string) error
 		}
 
 		// Send message and get response
-		response, err := o.sendMessage(endpoint, input)
+		var response string
+		var err error
+		if o.Raw {
+			response, err = o.sendRawMessage(endpoint, input)
+		} else {
+			response, err = o.sendMessage(endpoint, input)
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			continue
 		}
 
-		fmt.Println(response)
-		fmt.Println()
+		fmt.Fprintln(out, response)
+		if !o.Raw {
+			fmt.Fprintln(out)
+		}
 	}
 }
 
-func (o *ChatOptions) handleCommand(command, modelName string) bool {
+func (o *ChatOptions) handleCommand(out io.Writer, command, modelName string) bool {
 	klog.V(4).Infof("Handling command: %s", command)
 
 	parts := strings.Fields(command)
@@ -388,89 +421,94 @@ func (o *ChatOptions) handleCommand(command, modelName string) bool {
 
 	switch parts[0] {
 	case "/help":
-		fmt.Println("Available commands:")
-		fmt.Println("  /help        - Show this help message")
-		fmt.Println("  /quit        - Exit the chat session")
-		fmt.Println("  /clear       - Clear the conversation history")
-		fmt.Println("  /model       - Show current model information")
-		fmt.Println("  /params      - Show current inference parameters")
-		fmt.Println("  /set <param> <value> - Set inference parameter (temperature, max_tokens, etc.)")
-		fmt.Println()
+		fmt.Fprintln(out, "Available commands:")
+		fmt.Fprintln(out, "  /help        - Show this help message")
+		fmt.Fprintln(out, "  /quit        - Exit the chat session")
+		fmt.Fprintln(out, "  /clear       - Clear the conversation history")
+		fmt.Fprintln(out, "  /model       - Show current model information")
+		fmt.Fprintln(out, "  /params      - Show current inference parameters")
+		fmt.Fprintln(out, "  /set <param> <value> - Set inference parameter (temperature, max_tokens, etc.)")
+		fmt.Fprintln(out)
 
 	case "/quit", "/exit":
-		fmt.Println("Chat session ended.")
+		fmt.Fprintln(out, "Chat session ended.")
 		return true
 
 	case "/clear":
-		fmt.Print("\033[2J\033[H") // Clear screen
-		fmt.Printf("Connected to workspace: %s (model: %s)\n", o.WorkspaceName, modelName)
-		fmt.Println("Type /help for commands or /quit to exit.")
-		fmt.Println()
+		fmt.Fprint(out, "\033[2J\033[H") // Clear screen
+		fmt.Fprintf(out, "Connected to workspace: %s (model: %s)\n", o.WorkspaceName, modelName)
+		fmt.Fprintln(out, "Type /help for commands or /quit to exit.")
+		fmt.Fprintln(out)
 
 	case "/model":
-		fmt.Printf("Current model: %s\n", modelName)
-		fmt.Printf("Workspace: %s\n", o.WorkspaceName)
-		fmt.Printf("Namespace: %s\n", o.Namespace)
-		fmt.Println()
+		fmt.Fprintf(out, "Current model: %s\n", modelName)
+		fmt.Fprintf(out, "Workspace: %s\n", o.WorkspaceName)
+		fmt.Fprintf(out, "Namespace: %s\n", o.Namespace)
+		fmt.Fprintln(out)
 
 	case "/params":
-		fmt.Println("Current inference parameters:")
-		fmt.Printf("  Temperature: %.1f\n", o.Temperature)
-		fmt.Printf("  Max tokens: %d\n", o.MaxTokens)
-		fmt.Printf("  Top-p: %.1f\n", o.TopP)
-		fmt.Println()
+		fmt.Fprintln(out, "Current inference parameters:")
+		fmt.Fprintf(out, "  Temperature: %.1f\n", o.Temperature)
+		fmt.Fprintf(out, "  Max tokens: %d\n", o.MaxTokens)
+		fmt.Fprintf(out, "  Top-p: %.1f\n", o.TopP)
+		fmt.Fprintln(out)
 
 	case "/set":
+		if o.Raw {
+			fmt.Fprintln(out, "/set is not supported in raw mode; put inference parameters in the JSON request body.")
+			fmt.Fprintln(out)
+			return false
+		}
 		if len(parts) < 3 {
-			fmt.Println("Usage: /set <parameter> <value>")
-			fmt.Println("Available parameters: temperature, max_tokens, top_p")
-			fmt.Println()
+			fmt.Fprintln(out, "Usage: /set <parameter> <value>")
+			fmt.Fprintln(out, "Available parameters: temperature, max_tokens, top_p")
+			fmt.Fprintln(out)
 			return false
 		}
-		o.setParameter(parts[1], parts[2])
+		o.setParameter(out, parts[1], parts[2])
 
 	default:
-		fmt.Printf("Unknown command: %s\n", parts[0])
-		fmt.Println("Type /help for available commands.")
-		fmt.Println()
+		fmt.Fprintf(out, "Unknown command: %s\n", parts[0])
+		fmt.Fprintln(out, "Type /help for available commands.")
+		fmt.Fprintln(out)
 	}
 
 	return false
 }
 
-func (o *ChatOptions) setParameter(param, value string) {
+func (o *ChatOptions) setParameter(out io.Writer, param, value string) {
 	klog.V(4).Infof("Setting parameter %s to %s", param, value)
 
 	switch param {
 	case "temperature":
 		if temp, err := strconv.ParseFloat(value, 64); err == nil && temp >= 0.0 && temp <= 2.0 {
 			o.Temperature = temp
-			fmt.Printf("Temperature set to %.1f\n", temp)
+			fmt.Fprintf(out, "Temperature set to %.1f\n", temp)
 		} else {
-			fmt.Println("Invalid temperature value. Must be between 0.0 and 2.0")
+			fmt.Fprintln(out, "Invalid temperature value. Must be between 0.0 and 2.0")
 		}
 
 	case "max_tokens":
 		if tokens, err := strconv.Atoi(value); err == nil && tokens > 0 {
 			o.MaxTokens = tokens
-			fmt.Printf("Max tokens set to %d\n", tokens)
+			fmt.Fprintf(out, "Max tokens set to %d\n", tokens)
 		} else {
-			fmt.Println("Invalid max_tokens value. Must be a positive integer")
+			fmt.Fprintln(out, "Invalid max_tokens value. Must be a positive integer")
 		}
 
 	case "top_p":
 		if topP, err := strconv.ParseFloat(value, 64); err == nil && topP >= 0.0 && topP <= 1.0 {
 			o.TopP = topP
-			fmt.Printf("Top-p set to %.1f\n", topP)
+			fmt.Fprintf(out, "Top-p set to %.1f\n", topP)
 		} else {
-			fmt.Println("Invalid top_p value. Must be between 0.0 and 1.0")
+			fmt.Fprintln(out, "Invalid top_p value. Must be between 0.0 and 1.0")
 		}
 
 	default:
-		fmt.Printf("Unknown parameter: %s\n", param)
-		fmt.Println("Available parameters: temperature, max_tokens, top_p")
+		fmt.Fprintf(out, "Unknown parameter: %s\n", param)
+		fmt.Fprintln(out, "Available parameters: temperature, max_tokens, top_p")
 	}
-	fmt.Println()
+	fmt.Fprintln(out)
 }
 
 func (o *ChatOptions) sendMessage(endpoint, message string) (string, error) {
@@ -491,6 +529,22 @@ func (o *ChatOptions) sendMessage(endpoint, message string) (string, error) {
 	return o.extractMessageContent(response)
 }
 
+// sendRawMessage posts the user's input verbatim as the request body and returns the raw response
+func (o *ChatOptions) sendRawMessage(endpoint, body string) (string, error) {
+	klog.V(4).Infof("Sending raw request to endpoint: %s", endpoint)
+
+	if !json.Valid([]byte(body)) {
+		return "", fmt.Errorf("input is not valid JSON")
+	}
+
+	response, err := o.postRequest(endpoint, []byte(body))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(response)), nil
+}
+
 func (o *ChatOptions) buildRequestPayload(message string) map[string]interface{} {
 	payload := map[string]interface{}{
 		"messages": []map[string]string{
@@ -518,6 +572,22 @@ func (o *ChatOptions) buildRequestPayload(message string) map[string]interface{}
 }
 
 func (o *ChatOptions) makeHTTPRequest(endpoint string, jsonData []byte) (map[string]interface{}, error) {
+	body, err := o.postRequest(endpoint, jsonData)
+	if err != nil {
+		return nil, err
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		klog.Errorf("Failed to parse response: %v", err)
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return response, nil
+}
+
+// postRequest sends a JSON body to the endpoint and returns the response body
+func (o *ChatOptions) postRequest(endpoint string, jsonData []byte) ([]byte, error) {
 	client, err := o.createHTTPClient(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
@@ -541,18 +611,17 @@ func (o *ChatOptions) makeHTTPRequest(endpoint string, jsonData []byte) (map[str
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	var response map[string]interface{}
-	if err := json.Unmarshal(body, &response); err != nil {
-		klog.Errorf("Failed to parse response: %v", err)
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return response, nil
+	return body, nil
 }
 
 // createHTTPClient creates an HTTP client with proper authentication for API proxy endpoints
 func (o *ChatOptions) createHTTPClient(endpoint string) (*http.Client, error) {
-	client := &http.Client{Timeout: 30 * time.Second}
+	// Options built without the flag (requestTimeout unset) get the flag's default
+	timeout := o.requestTimeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	client := &http.Client{Timeout: timeout}
 
 	// If this is an API proxy endpoint, we need to add authentication
 	if strings.Contains(endpoint, "/api/v1/namespaces/") {
@@ -17,6 +17,12 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,6 +62,8 @@ func TestNewChatCmd(t *testing.T) {
 			"top-p",
 			"max-tokens",
 			"system-prompt",
+			"raw",
+			"request-timeout",
 		}
 
 		for _, flagName := range optionalFlags {
@@ -156,6 +164,54 @@ func TestChatOptionsValidation(t *testing.T) {
 			expectError: true,
 			errorMsg:    "max-tokens must be greater than 0",
 		},
+		{
+			name: "Raw mode",
+			options: ChatOptions{
+				WorkspaceName: "test-workspace",
+				Temperature:   0.7,
+				TopP:          0.9,
+				MaxTokens:     1024,
+				Raw:           true,
+			},
+			expectError: false,
+		},
+		{
+			name: "Malformed request timeout",
+			options: ChatOptions{
+				WorkspaceName:  "test-workspace",
+				Temperature:    0.7,
+				TopP:           0.9,
+				MaxTokens:      1024,
+				RequestTimeout: "ten seconds",
+			},
+			expectError: true,
+			errorMsg:    "invalid --request-timeout value",
+		},
+		{
+			name: "Zero request timeout",
+			options: ChatOptions{
+				WorkspaceName:  "test-workspace",
+				Temperature:    0.7,
+				TopP:           0.9,
+				MaxTokens:      1024,
+				RequestTimeout: "0s",
+			},
+			expectError: true,
+			errorMsg:    "duration must be greater than 0",
+		},
+		{
+			name: "Raw mode with system prompt",
+			options: ChatOptions{
+				WorkspaceName: "test-workspace",
+				Temperature:   0.7,
+				TopP:          0.9,
+				MaxTokens:     1024,
+				Raw:           true,
+				SystemPrompt:  "You are helpful",
+			},
+			expectError: true,
+			errorMsg:    "--system-prompt cannot be used with --raw",
+		},
 		{
 			name: "Valid edge values",
 			options: ChatOptions{
@@ -281,7 +337,7 @@ func TestChatOptionsMethodsExist(t *testing.T) {
 	t.Run("methods exist and are callable", func(t *testing.T) {
 		// Test that we can call the parameter setting method
 		assert.NotPanics(t, func() {
-			options.setParameter("temperature", "0.8")
+			options.setParameter(io.Discard, "temperature", "0.8")
 		})
 
 		// Verify the parameter was set
@@ -316,3 +372,55 @@ func TestChatCommandIntegration(t *testing.T) {
 		assert.Equal(t, "0.9", topPFlag.DefValue)
 	})
 }
+
+func TestChatSendRawMessage(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":"chatcmpl-1","choices":[]}`)
+	}))
+	defer server.Close()
+
+	options := &ChatOptions{WorkspaceName: "test-workspace", Raw: true}
+
+	t.Run("Valid JSON is sent verbatim", func(t *testing.T) {
+		request := `{"messages":[{"role":"user","content":"hi"}],"stream":false}`
+		response, err := options.sendRawMessage(server.URL, request)
+		assert.NoError(t, err)
+		assert.Equal(t, request, received)
+		assert.Equal(t, `{"id":"chatcmpl-1","choices":[]}`, response)
+	})
+
+	t.Run("Invalid JSON is rejected", func(t *testing.T) {
+		received = ""
+		_, err := options.sendRawMessage(server.URL, `{"messages":`)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not valid JSON")
+		assert.Empty(t, received)
+	})
+}
+
+func TestChatRawSessionOutput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":"chatcmpl-1","choices":[]}`)
+	}))
+	defer server.Close()
+
+	options := &ChatOptions{WorkspaceName: "test-workspace", Raw: true, Temperature: 0.7}
+	input := strings.NewReader("/set temperature 1.5\n" + `{"messages":[{"role":"user","content":"hi"}]}` + "\n")
+
+	var out, ui bytes.Buffer
+	err := options.runChatLoop(input, &out, &ui, server.URL, "phi-3.5-mini-instruct")
+	assert.NoError(t, err, "end of input should end the session cleanly")
+
+	// stdout carries only the raw responses
+	assert.Equal(t, "{\"id\":\"chatcmpl-1\",\"choices\":[]}\n", out.String())
+
+	assert.Contains(t, ui.String(), "Connected to workspace: test-workspace")
+	assert.Contains(t, ui.String(), ">>> ")
+	assert.Contains(t, ui.String(), "/set is not supported in raw mode")
+	assert.Equal(t, 0.7, options.Temperature)
+}
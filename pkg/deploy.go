@@ -19,18 +19,29 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/yaml"
 )
 
+// expiresAtAnnotation records when a workspace created with --ttl may be garbage collected
+const expiresAtAnnotation = "kaito.sh/expires-at"
+
 // DeployOptions holds the options for the deploy command
 type DeployOptions struct {
 	configFlags        *genericclioptions.ConfigFlags
@@ -52,16 +63,31 @@ type DeployOptions struct {
 	OutputPVC          string
 	ModelAccessMode    string
 	ModelImage         string
+	FromFile           string
+	TTL                string
+	WaitTimeout        string
+	WaitOutput         string
 	Count              int
 	DryRun             bool
 	EnableLoadBalancer bool
 	Tuning             bool
+	ContinueOnError    bool
+	Wait               bool
+	NoFallback         bool
+
+	ttl         time.Duration
+	waitTimeout time.Duration
+	flags       *pflag.FlagSet
+	// model is the catalog entry resolved during validation
+	model Model
 }
 
 // NewDeployCmd creates the deploy command
 func NewDeployCmd(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
 	o := &DeployOptions{
 		configFlags: configFlags,
+		WaitTimeout: "30m",
+		WaitOutput:  WaitOutputText,
 	}
 
 	cmd := &cobra.Command{
@@ -88,7 +114,19 @@ the specified model according to Kaito's preset configurations.`,
   kubectl kaito deploy --workspace-name tune-llama --model llama-3.1-8b-instruct --tuning --input-pvc training-data --output-pvc model-output
 
   # Deploy with load balancer for external access (inference mode)
-  kubectl kaito deploy --workspace-name public-llama --model llama-3.1-8b-instruct --enable-load-balancer`,
+  kubectl kaito deploy --workspace-name public-llama --model llama-3.1-8b-instruct --enable-load-balancer
+
+  # Deploy a short-lived test workspace that 'kubectl kaito gc' deletes after 4 hours
+  kubectl kaito deploy --workspace-name test-phi --model phi-3.5-mini-instruct --ttl 4h
+
+  # Deploy and wait for readiness, emitting JSON progress lines for CI
+  kubectl kaito deploy --workspace-name ci-phi --model phi-3.5-mini-instruct --wait --wait-timeout 45m --wait-output json
+
+  # Deploy every Workspace and RAGEngine manifest in a directory
+  kubectl kaito deploy --from-file ./workspaces/ --continue-on-error
+
+  # Fail if the model cannot be validated against the official catalog (for CI)
+  kubectl kaito deploy --workspace-name ci-phi --model phi-3.5-mini-instruct --no-fallback`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := o.Validate(); err != nil {
 				return err
@@ -97,9 +135,14 @@ the specified model according to Kaito's preset configurations.`,
 		},
 	}
 
-	// Required flags
-	cmd.Flags().StringVar(&o.WorkspaceName, "workspace-name", "", "Name of the workspace to create (required)")
-	cmd.Flags().StringVar(&o.Model, "model", "", "Model name to deploy (required)")
+	// Required flags (unless deploying from manifests)
+	cmd.Flags().StringVar(&o.WorkspaceName, "workspace-name", "", "Name of the workspace to create (required unless --from-file is set)")
+	cmd.Flags().StringVar(&o.Model, "model", "", "Model name to deploy (required unless --from-file is set)")
+	cmd.Flags().BoolVar(&o.NoFallback, "no-fallback", false, "Require validation against the official model catalog instead of the built-in list")
+
+	// Manifest options
+	cmd.Flags().StringVarP(&o.FromFile, "from-file", "f", "", "Manifest file, directory, or glob of Workspace/RAGEngine manifests to deploy")
+	cmd.Flags().BoolVar(&o.ContinueOnError, "continue-on-error", false, "Keep deploying remaining manifests when one fails (with --from-file)")
 
 	// Resource configuration
 	cmd.Flags().StringVar(&o.InstanceType, "instance-type", "", "GPU instance type (e.g., Standard_NC6s_v3)")
@@ -124,14 +167,12 @@ the specified model according to Kaito's preset configurations.`,
 	// Special options
 	cmd.Flags().BoolVar(&o.DryRun, "dry-run", false, "Show what would be created without actually creating")
 	cmd.Flags().BoolVar(&o.EnableLoadBalancer, "enable-load-balancer", false, "Create LoadBalancer service for external access")
+	cmd.Flags().BoolVar(&o.Wait, "wait", false, "Wait for the workspace to become ready")
+	cmd.Flags().StringVar(&o.WaitTimeout, "wait-timeout", "30m", "Maximum time to wait with --wait (e.g. 30m, 1h)")
+	cmd.Flags().StringVar(&o.WaitOutput, "wait-output", WaitOutputText, "Progress output while waiting: text or json (JSON lines on stderr)")
+	cmd.Flags().StringVar(&o.TTL, "ttl", "", "Mark the workspace as expiring after this duration (e.g. 4h) for 'kaito gc'")
 
-	// Mark required flags
-	if err := cmd.MarkFlagRequired("workspace-name"); err != nil {
-		klog.Errorf("Failed to mark workspace-name flag as required: %v", err)
-	}
-	if err := cmd.MarkFlagRequired("model"); err != nil {
-		klog.Errorf("Failed to mark model flag as required: %v", err)
-	}
+	o.flags = cmd.Flags()
 
 	return cmd
 }
@@ -140,6 +181,23 @@ the specified model according to Kaito's preset configurations.`,
 func (o *DeployOptions) Validate() error {
 	klog.V(4).Info("Validating deploy options")
 
+	ttl, err := parsePositiveDurationFlag("ttl", o.TTL)
+	if err != nil {
+		return err
+	}
+	o.ttl = ttl
+
+	if err := o.validateWaitFlags(); err != nil {
+		return err
+	}
+
+	if o.FromFile != "" {
+		return o.validateFromFile()
+	}
+	if o.ContinueOnError {
+		return fmt.Errorf("--continue-on-error can only be used with --from-file")
+	}
+
 	if o.WorkspaceName == "" {
 		return fmt.Errorf("workspace name is required")
 	}
@@ -147,10 +205,13 @@ func (o *DeployOptions) Validate() error {
 		return fmt.Errorf("model name is required")
 	}
 
-	// Validate model name against official Kaito supported models
-	if err := ValidateModelName(o.Model); err != nil {
+	// Validate model name against official Kaito supported models, resolving aliases
+	model, err := resolveModel(o.Model, o.NoFallback)
+	if err != nil {
 		return err
 	}
+	o.model = model
+	o.Model = model.Name
 
 	// Check for conflicting inference/tuning parameters
 	if err := o.validateModeFlags(); err != nil {
@@ -171,6 +232,35 @@ func (o *DeployOptions) Validate() error {
 	return nil
 }
 
+// validateWaitFlags checks the --wait related flags
+func (o *DeployOptions) validateWaitFlags() error {
+	waitTimeout, err := parseDurationFlag("wait-timeout", o.WaitTimeout)
+	if err != nil {
+		return err
+	}
+	o.waitTimeout = waitTimeout
+
+	if o.WaitOutput != "" {
+		if err := validateWaitOutput(o.WaitOutput); err != nil {
+			return err
+		}
+	}
+
+	if o.Wait {
+		if o.DryRun {
+			return fmt.Errorf("--wait cannot be used with --dry-run")
+		}
+		if o.FromFile != "" {
+			return fmt.Errorf("--wait cannot be used with --from-file")
+		}
+		if o.waitTimeout == 0 {
+			return fmt.Errorf("--wait-timeout must be greater than 0")
+		}
+	}
+
+	return nil
+}
+
 // validateModeFlags ensures users don't mix inference and tuning parameters
 func (o *DeployOptions) validateModeFlags() error {
 	// Define inference-specific flags
@@ -228,20 +318,21 @@ func (o *DeployOptions) Run() error {
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
-	// Get namespace from config flags if not set
-	if o.Namespace == "" {
-		if ns, _, err := o.configFlags.ToRawKubeConfigLoader().Namespace(); err == nil && ns != "" {
-			o.Namespace = ns
-		} else {
-			klog.V(4).Info("No namespace specified, using 'default'")
-			o.Namespace = "default"
-		}
+	// Get namespace
+	o.Namespace = resolveNamespace(o.configFlags, o.Namespace)
+
+	if o.FromFile != "" {
+		return o.runFromFile()
 	}
 
 	if o.DryRun {
 		return o.showDryRun()
 	}
 
+	if size := o.estimateDownloadSize(); size != "" {
+		klog.V(2).Infof("Model %s weights are approximately %s; the first image pull may take a while", o.Model, size)
+	}
+
 	// Get REST config
 	config, err := o.configFlags.ToRESTConfig()
 	if err != nil {
@@ -256,33 +347,18 @@ func (o *DeployOptions) Run() error {
 		return fmt.Errorf("failed to create dynamic client: %w", err)
 	}
 
-	// Create workspace
-	workspace := o.buildWorkspace()
-
-	klog.V(2).Infof("Creating workspace %s in namespace %s", o.WorkspaceName, o.Namespace)
-
-	gvr := schema.GroupVersionResource{
-		Group:    "kaito.sh",
-		Version:  "v1beta1",
-		Resource: "workspaces",
+	if err := o.createWorkspace(context.TODO(), dynamicClient); err != nil {
+		return err
 	}
 
-	_, err = dynamicClient.Resource(gvr).Namespace(o.Namespace).Create(
-		context.TODO(),
-		workspace,
-		metav1.CreateOptions{},
-	)
-
-	if err != nil {
-		if errors.IsAlreadyExists(err) {
-			fmt.Printf("✓ Workspace %s already exists\n", o.WorkspaceName)
-			return nil
+	if o.Wait {
+		output := o.WaitOutput
+		if output == "" {
+			output = WaitOutputText
 		}
-		klog.Errorf("Failed to create workspace: %v", err)
-		return fmt.Errorf("failed to create workspace: %w", err)
+		return waitForWorkspaceReady(context.TODO(), dynamicClient, o.Namespace, o.WorkspaceName, o.waitTimeout, output, os.Stderr)
 	}
 
-	fmt.Printf("✓ Workspace %s created successfully\n", o.WorkspaceName)
 	fmt.Printf("ℹ️  Use 'kubectl kaito status --workspace-name %s' to check status\n", o.WorkspaceName)
 	return nil
 }
@@ -313,6 +389,9 @@ func (o *DeployOptions) buildWorkspace() *unstructured.Unstructured {
 		klog.V(4).Info("Added LoadBalancer annotation to workspace")
 	}
 
+	// Add expiry annotation if a TTL was requested
+	o.setExpiry(workspace)
+
 	// Add the spec fields at the top level (not inside a spec field)
 	spec := o.createWorkspaceSpec()
 	for key, value := range spec {
@@ -322,6 +401,58 @@ func (o *DeployOptions) buildWorkspace() *unstructured.Unstructured {
 	return workspace
 }
 
+// createWorkspace creates the workspace, leaving an existing one in place. An existing
+// workspace is never given an expiry: --ttl only schedules cleanup for workspaces this
+// command creates, since a same-named workspace may be long-lived.
+func (o *DeployOptions) createWorkspace(ctx context.Context, dynamicClient dynamic.Interface) error {
+	workspace := o.buildWorkspace()
+
+	klog.V(2).Infof("Creating workspace %s in namespace %s", o.WorkspaceName, o.Namespace)
+
+	gvr := schema.GroupVersionResource{
+		Group:    "kaito.sh",
+		Version:  "v1beta1",
+		Resource: "workspaces",
+	}
+
+	_, err := dynamicClient.Resource(gvr).Namespace(o.Namespace).Create(ctx, workspace, metav1.CreateOptions{})
+
+	switch {
+	case errors.IsAlreadyExists(err) && o.ttl > 0:
+		return errExistingWorkspaceTTL(o.WorkspaceName)
+	case errors.IsAlreadyExists(err):
+		fmt.Printf("✓ Workspace %s already exists\n", o.WorkspaceName)
+	case err != nil:
+		klog.Errorf("Failed to create workspace: %v", err)
+		return fmt.Errorf("failed to create workspace: %w", err)
+	default:
+		fmt.Printf("✓ Workspace %s created successfully\n", o.WorkspaceName)
+	}
+
+	return nil
+}
+
+// errExistingWorkspaceTTL reports that --ttl was not applied to a workspace that already existed
+func errExistingWorkspaceTTL(name string) error {
+	return fmt.Errorf("workspace %s already exists; --ttl only applies to newly created workspaces", name)
+}
+
+// setExpiry annotates the workspace with an absolute expiry time computed from --ttl
+func (o *DeployOptions) setExpiry(workspace *unstructured.Unstructured) {
+	if o.ttl <= 0 {
+		return
+	}
+
+	expiresAt := time.Now().Add(o.ttl).UTC().Format(time.RFC3339)
+	annotations := workspace.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[expiresAtAnnotation] = expiresAt
+	workspace.SetAnnotations(annotations)
+	klog.V(4).Infof("Workspace %s expires at %s", workspace.GetName(), expiresAt)
+}
+
 func (o *DeployOptions) createWorkspaceSpec() map[string]interface{} {
 	klog.V(4).Info("Creating workspace specification")
 
@@ -439,6 +570,12 @@ func (o *DeployOptions) createWorkspaceSpec() map[string]interface{} {
 	return spec
 }
 
+// estimateDownloadSize returns the approximate weight download size for the model, or "" if unknown.
+// It reuses the catalog entry resolved during validation rather than fetching the catalog again.
+func (o *DeployOptions) estimateDownloadSize() string {
+	return estimateModelDownloadSize(o.model)
+}
+
 func (o *DeployOptions) showDryRun() error {
 	klog.V(2).Info("Running in dry-run mode")
 
@@ -455,6 +592,10 @@ func (o *DeployOptions) showDryRun() error {
 		fmt.Printf("Instance Type: %s\n", o.InstanceType)
 	}
 
+	if size := o.estimateDownloadSize(); size != "" {
+		fmt.Printf("Estimated Download Size: %s (model weights are pulled on first start, which may take a while)\n", size)
+	}
+
 	if o.Tuning {
 		fmt.Printf("Mode: Fine-tuning (%s)\n", o.TuningMethod)
 		if len(o.InputURLs) > 0 {
@@ -491,6 +632,10 @@ func (o *DeployOptions) showDryRun() error {
 		}
 	}
 
+	if o.ttl > 0 {
+		fmt.Printf("TTL: %s (eligible for 'kubectl kaito gc' after expiry)\n", o.ttl)
+	}
+
 	if len(o.LabelSelector) > 0 {
 		fmt.Printf("Label Selector: %v\n", o.LabelSelector)
 	}
@@ -516,3 +661,383 @@ func (o *DeployOptions) showDryRun() error {
 
 	return nil
 }
+
+// manifestResult records the outcome of deploying a single manifest object
+type manifestResult struct {
+	File      string
+	Kind      string
+	Name      string
+	Namespace string
+	Err       error
+	Unchanged bool
+}
+
+// flagChanged reports whether a flag was set on the command line. Options built
+// without a command (as in tests) have no flag set and report false.
+func (o *DeployOptions) flagChanged(name string) bool {
+	return o.flags != nil && o.flags.Changed(name)
+}
+
+// validateFromFile checks that --from-file is not mixed with flags that only apply
+// to a single generated workspace, since manifests carry their own spec
+func (o *DeployOptions) validateFromFile() error {
+	workspaceFlags := []struct {
+		name string
+		set  bool
+	}{
+		{"workspace-name", o.WorkspaceName != ""},
+		{"model", o.Model != ""},
+		{"instance-type", o.InstanceType != ""},
+		{"count", o.flagChanged("count")},
+		{"node-selector", len(o.LabelSelector) > 0},
+		{"model-access-secret", o.ModelAccessSecret != ""},
+		{"adapters", len(o.Adapters) > 0},
+		{"inference-config", o.InferenceConfig != ""},
+		{"enable-load-balancer", o.EnableLoadBalancer},
+		{"tuning", o.Tuning},
+		{"tuning-method", o.flagChanged("tuning-method")},
+		{"input-urls", len(o.InputURLs) > 0},
+		{"output-image", o.OutputImage != ""},
+		{"output-image-secret", o.OutputImageSecret != ""},
+		{"tuning-config", o.TuningConfig != ""},
+		{"input-pvc", o.InputPVC != ""},
+		{"output-pvc", o.OutputPVC != ""},
+		{"wait", o.Wait},
+		{"wait-timeout", o.flagChanged("wait-timeout")},
+		{"wait-output", o.flagChanged("wait-output")},
+	}
+
+	for _, flag := range workspaceFlags {
+		if flag.set {
+			return fmt.Errorf("--from-file cannot be combined with --%s", flag.name)
+		}
+	}
+
+	klog.V(4).Info("From-file validation completed successfully")
+	return nil
+}
+
+// collectManifestFiles expands a file, directory, or glob into a sorted list of manifest files
+func collectManifestFiles(path string) ([]string, error) {
+	klog.V(4).Infof("Collecting manifest files from: %s", path)
+
+	var files []string
+
+	if info, err := os.Stat(path); err == nil {
+		if !info.IsDir() {
+			return []string{path}, nil
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory %s: %w", path, err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !isManifestFile(entry.Name()) {
+				continue
+			}
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	} else {
+		matches, globErr := filepath.Glob(path)
+		if globErr != nil {
+			return nil, fmt.Errorf("invalid --from-file pattern %s: %w", path, globErr)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		for _, match := range matches {
+			if info, err := os.Stat(match); err == nil && !info.IsDir() {
+				files = append(files, match)
+			}
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no manifest files found in %s", path)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func isManifestFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// loadManifests decodes every YAML or JSON document in a manifest file
+func loadManifests(file string) ([]*unstructured.Unstructured, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file, err)
+	}
+	defer f.Close()
+
+	var objects []*unstructured.Unstructured
+	decoder := utilyaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		// Skip empty documents such as a trailing "---"
+		if len(obj.Object) == 0 {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("no manifests found in %s", file)
+	}
+
+	return objects, nil
+}
+
+// validateManifest checks that a manifest is a supported Kaito kind with a model from models
+func validateManifest(obj *unstructured.Unstructured, models []Model) (schema.GroupVersionResource, error) {
+	gv, err := schema.ParseGroupVersion(obj.GetAPIVersion())
+	if err != nil || gv.Group != "kaito.sh" {
+		return schema.GroupVersionResource{}, fmt.Errorf("unsupported apiVersion '%s', expected kaito.sh", obj.GetAPIVersion())
+	}
+	if obj.GetName() == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("%s manifest is missing metadata.name", obj.GetKind())
+	}
+
+	switch obj.GetKind() {
+	case "Workspace":
+		presetPath := []string{"inference", "preset", "name"}
+		model, _, _ := unstructured.NestedString(obj.Object, presetPath...)
+		if model == "" {
+			presetPath = []string{"tuning", "preset", "name"}
+			model, _, _ = unstructured.NestedString(obj.Object, presetPath...)
+		}
+		if model == "" {
+			return schema.GroupVersionResource{}, fmt.Errorf("workspace %s does not specify a preset model", obj.GetName())
+		}
+		canonical, found := resolveModelAlias(model, models)
+		if !found {
+			return schema.GroupVersionResource{}, unsupportedModelError(model, models)
+		}
+		if canonical != model {
+			klog.Infof("Using canonical model name '%s' for '%s' in workspace %s", canonical, model, obj.GetName())
+		}
+		// Write back the canonical name so the operator never sees an alias
+		if err := unstructured.SetNestedField(obj.Object, canonical, presetPath...); err != nil {
+			return schema.GroupVersionResource{}, fmt.Errorf("failed to set preset name for workspace %s: %w", obj.GetName(), err)
+		}
+		return gv.WithResource("workspaces"), nil
+	case "RAGEngine":
+		// RAGEngine presets are embedding models, which are not part of the workspace model catalog
+		return gv.WithResource("ragengines"), nil
+	default:
+		return schema.GroupVersionResource{}, fmt.Errorf("unsupported kind '%s', expected Workspace or RAGEngine", obj.GetKind())
+	}
+}
+
+// runFromFile deploys every manifest found in --from-file and reports per-file results
+func (o *DeployOptions) runFromFile() error {
+	klog.V(2).Infof("Deploying manifests from: %s", o.FromFile)
+
+	files, err := collectManifestFiles(o.FromFile)
+	if err != nil {
+		return err
+	}
+
+	var dynamicClient dynamic.Interface
+	if !o.DryRun {
+		config, err := o.configFlags.ToRESTConfig()
+		if err != nil {
+			klog.Errorf("Failed to get REST config: %v", err)
+			return fmt.Errorf("failed to get REST config: %w", err)
+		}
+
+		dynamicClient, err = dynamic.NewForConfig(config)
+		if err != nil {
+			klog.Errorf("Failed to create dynamic client: %v", err)
+			return fmt.Errorf("failed to create dynamic client: %w", err)
+		}
+	}
+
+	results, err := o.deployManifestFiles(dynamicClient, files)
+	if err != nil {
+		return err
+	}
+	return printManifestSummary(results, len(files))
+}
+
+// manifestObject is a loaded manifest object, the resource it maps to, and its outcome
+type manifestObject struct {
+	obj    *unstructured.Unstructured
+	gvr    schema.GroupVersionResource
+	result manifestResult
+}
+
+// deployManifestFiles validates every manifest before creating any of them. Unless
+// --continue-on-error is set, one invalid manifest means nothing is created and the
+// first failed create stops the run; otherwise invalid manifests are skipped.
+func (o *DeployOptions) deployManifestFiles(dynamicClient dynamic.Interface, files []string) ([]manifestResult, error) {
+	objects, err := o.validateManifestFiles(files)
+	if err != nil {
+		return nil, err
+	}
+
+	if !o.ContinueOnError {
+		failed := 0
+		for _, object := range objects {
+			if object.result.Err != nil {
+				printManifestResult(object.result, o.DryRun)
+				failed++
+			}
+		}
+		if failed > 0 {
+			return nil, fmt.Errorf("%d of %d manifests failed validation; nothing was created", failed, len(objects))
+		}
+	}
+
+	var results []manifestResult
+	for _, object := range objects {
+		if object.result.Err == nil && !o.DryRun {
+			object.result.Unchanged, object.result.Err = o.createManifestObject(dynamicClient, object)
+		}
+
+		printManifestResult(object.result, o.DryRun)
+		results = append(results, object.result)
+
+		if object.result.Err != nil && !o.ContinueOnError {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// validateManifestFiles loads and validates every manifest object in files. Load and
+// validation failures are recorded per object; only a failed catalog fetch is returned.
+// The model catalog is fetched at most once, and only if a Workspace needs it.
+func (o *DeployOptions) validateManifestFiles(files []string) ([]manifestObject, error) {
+	var objects []manifestObject
+	needsCatalog := false
+
+	for _, file := range files {
+		loaded, err := loadManifests(file)
+		if err != nil {
+			objects = append(objects, manifestObject{result: manifestResult{File: file, Err: err}})
+			continue
+		}
+
+		for _, obj := range loaded {
+			if obj.GetNamespace() == "" {
+				obj.SetNamespace(o.Namespace)
+			}
+			if obj.GetKind() == "Workspace" {
+				needsCatalog = true
+			}
+			objects = append(objects, manifestObject{
+				obj: obj,
+				result: manifestResult{
+					File:      file,
+					Kind:      obj.GetKind(),
+					Name:      obj.GetName(),
+					Namespace: obj.GetNamespace(),
+				},
+			})
+		}
+	}
+
+	var models []Model
+	if needsCatalog {
+		var err error
+		models, err = getSupportedModels(o.NoFallback)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range objects {
+		object := &objects[i]
+		if object.obj == nil {
+			continue
+		}
+
+		object.gvr, object.result.Err = validateManifest(object.obj, models)
+		if object.result.Err == nil && object.obj.GetKind() == "Workspace" {
+			o.setExpiry(object.obj)
+		}
+	}
+
+	return objects, nil
+}
+
+// createManifestObject creates a validated manifest object, reporting whether it already existed
+func (o *DeployOptions) createManifestObject(dynamicClient dynamic.Interface, object manifestObject) (bool, error) {
+	result := object.result
+	klog.V(2).Infof("Creating %s %s in namespace %s", result.Kind, result.Name, result.Namespace)
+
+	_, err := dynamicClient.Resource(object.gvr).Namespace(result.Namespace).Create(
+		context.TODO(),
+		object.obj,
+		metav1.CreateOptions{},
+	)
+
+	switch {
+	case errors.IsAlreadyExists(err) && result.Kind == "Workspace" && o.ttl > 0:
+		return false, errExistingWorkspaceTTL(result.Name)
+	case errors.IsAlreadyExists(err):
+		klog.V(3).Infof("%s %s already exists, leaving it unchanged", result.Kind, result.Name)
+		return true, nil
+	case err != nil:
+		klog.Errorf("Failed to create %s %s: %v", result.Kind, result.Name, err)
+		return false, err
+	}
+	return false, nil
+}
+
+func printManifestResult(result manifestResult, dryRun bool) {
+	if result.Err != nil {
+		if result.Kind == "" {
+			fmt.Printf("✗ %s: %v\n", result.File, result.Err)
+		} else {
+			fmt.Printf("✗ %s: %s %s/%s: %v\n", result.File, result.Kind, result.Namespace, result.Name, result.Err)
+		}
+		return
+	}
+
+	action := "created"
+	switch {
+	case dryRun:
+		action = "valid (dry-run)"
+	case result.Unchanged:
+		action = "unchanged (already exists)"
+	}
+	fmt.Printf("✓ %s: %s %s/%s %s\n", result.File, result.Kind, result.Namespace, result.Name, action)
+}
+
+func printManifestSummary(results []manifestResult, fileCount int) error {
+	failed, unchanged := 0, 0
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			failed++
+		case result.Unchanged:
+			unchanged++
+		}
+	}
+
+	fmt.Println()
+	fmt.Printf("Summary: %d succeeded, %d unchanged, %d failed (%d files)\n",
+		len(results)-failed-unchanged, unchanged, failed, fileCount)
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d manifests failed to deploy", failed, len(results))
+	}
+	return nil
+}
@@ -17,10 +17,22 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 )
 
 func TestDeployCmd(t *testing.T) {
@@ -163,6 +175,114 @@ func TestDeployOptionsValidation(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "From file without workspace flags",
+			options: DeployOptions{
+				FromFile:        "./workspaces/",
+				ContinueOnError: true,
+			},
+			expectError: false,
+		},
+		{
+			name: "From file with model - should fail",
+			options: DeployOptions{
+				FromFile: "./workspaces/",
+				Model:    "phi-3.5-mini-instruct",
+			},
+			expectError: true,
+		},
+		{
+			name: "From file with instance type - should fail",
+			options: DeployOptions{
+				FromFile:     "./workspaces/",
+				InstanceType: "Standard_NC6s_v3",
+			},
+			expectError: true,
+		},
+		{
+			name: "From file with load balancer - should fail",
+			options: DeployOptions{
+				FromFile:           "./workspaces/",
+				EnableLoadBalancer: true,
+			},
+			expectError: true,
+		},
+		{
+			name: "From file with node selector - should fail",
+			options: DeployOptions{
+				FromFile:      "./workspaces/",
+				LabelSelector: map[string]string{"apps": "llm"},
+			},
+			expectError: true,
+		},
+		{
+			name: "From file with adapters - should fail",
+			options: DeployOptions{
+				FromFile: "./workspaces/",
+				Adapters: []string{"my-adapter"},
+			},
+			expectError: true,
+		},
+		{
+			name: "From file with wait - should fail",
+			options: DeployOptions{
+				FromFile: "./workspaces/",
+				Wait:     true,
+			},
+			expectError: true,
+		},
+		{
+			name: "Continue on error without from file - should fail",
+			options: DeployOptions{
+				WorkspaceName:   "test-workspace",
+				Model:           "phi-3.5-mini-instruct",
+				ContinueOnError: true,
+			},
+			expectError: true,
+		},
+		{
+			name: "Wait with JSON output",
+			options: DeployOptions{
+				WorkspaceName: "test-workspace",
+				Model:         "phi-3.5-mini-instruct",
+				Wait:          true,
+				WaitTimeout:   "45m",
+				WaitOutput:    "json",
+			},
+			expectError: false,
+		},
+		{
+			name: "Wait with invalid output - should fail",
+			options: DeployOptions{
+				WorkspaceName: "test-workspace",
+				Model:         "phi-3.5-mini-instruct",
+				Wait:          true,
+				WaitTimeout:   "45m",
+				WaitOutput:    "yaml",
+			},
+			expectError: true,
+		},
+		{
+			name: "Wait with dry run - should fail",
+			options: DeployOptions{
+				WorkspaceName: "test-workspace",
+				Model:         "phi-3.5-mini-instruct",
+				Wait:          true,
+				WaitTimeout:   "45m",
+				DryRun:        true,
+			},
+			expectError: true,
+		},
+		{
+			name: "Malformed wait timeout - should fail",
+			options: DeployOptions{
+				WorkspaceName: "test-workspace",
+				Model:         "phi-3.5-mini-instruct",
+				Wait:          true,
+				WaitTimeout:   "forever",
+			},
+			expectError: true,
+		},
 		{
 			name: "Tuning mode with LoadBalancer - should fail",
 			options: DeployOptions{
@@ -247,3 +367,369 @@ func TestBuildWorkspaceWithLoadBalancer(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployResolvesModelAlias(t *testing.T) {
+	options := &DeployOptions{
+		WorkspaceName: "test-workspace",
+		Model:         "phi3.5",
+	}
+	require.NoError(t, options.Validate())
+	assert.Equal(t, "phi-3.5-mini-instruct", options.Model)
+
+	workspace := options.buildWorkspace()
+	preset, _, _ := unstructured.NestedString(workspace.Object, "inference", "preset", "name")
+	assert.Equal(t, "phi-3.5-mini-instruct", preset)
+}
+
+func TestEstimateDownloadSizeUsesResolvedModel(t *testing.T) {
+	options := &DeployOptions{
+		Model: "phi-4",
+		model: Model{Name: "phi-4", Properties: map[string]string{"downloadSize": "28GB"}},
+	}
+	assert.Equal(t, "~28GB", options.estimateDownloadSize())
+
+	// Without catalog properties the estimate comes from the resolved model name
+	options = &DeployOptions{
+		Model: "llama-3.1-8b-instruct",
+		model: Model{Name: "llama-3.1-8b-instruct"},
+	}
+	assert.Equal(t, "~16GB", options.estimateDownloadSize())
+}
+
+func TestBuildWorkspaceWithTTL(t *testing.T) {
+	options := &DeployOptions{
+		WorkspaceName: "test-workspace",
+		Model:         "phi-3.5-mini-instruct",
+		Namespace:     "default",
+		TTL:           "2h",
+	}
+	require.NoError(t, options.Validate())
+
+	before := time.Now()
+	workspace := options.buildWorkspace()
+
+	expiresAt, err := time.Parse(time.RFC3339, workspace.GetAnnotations()[expiresAtAnnotation])
+	require.NoError(t, err)
+	assert.WithinDuration(t, before.Add(2*time.Hour), expiresAt, time.Minute)
+
+	t.Run("No TTL", func(t *testing.T) {
+		options := &DeployOptions{WorkspaceName: "test-workspace", Model: "phi-3.5-mini-instruct"}
+		workspace := options.buildWorkspace()
+		_, found := workspace.GetAnnotations()[expiresAtAnnotation]
+		assert.False(t, found)
+	})
+
+	t.Run("Zero TTL", func(t *testing.T) {
+		options := &DeployOptions{WorkspaceName: "test-workspace", Model: "phi-3.5-mini-instruct", TTL: "0s"}
+		err := options.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--ttl")
+	})
+
+	t.Run("Malformed TTL", func(t *testing.T) {
+		options := &DeployOptions{WorkspaceName: "test-workspace", Model: "phi-3.5-mini-instruct", TTL: "2 hours"}
+		assert.Error(t, options.Validate())
+	})
+}
+
+func TestCollectManifestFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b.yaml", "a.yml", "c.json", "notes.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0o600))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "nested.yaml"), 0o755))
+
+	t.Run("Directory", func(t *testing.T) {
+		files, err := collectManifestFiles(dir)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "a.yml"),
+			filepath.Join(dir, "b.yaml"),
+			filepath.Join(dir, "c.json"),
+		}, files)
+	})
+
+	t.Run("Single file", func(t *testing.T) {
+		files, err := collectManifestFiles(filepath.Join(dir, "b.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "b.yaml")}, files)
+	})
+
+	t.Run("Glob", func(t *testing.T) {
+		files, err := collectManifestFiles(filepath.Join(dir, "*.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "b.yaml")}, files)
+	})
+
+	t.Run("Missing path", func(t *testing.T) {
+		_, err := collectManifestFiles(filepath.Join(dir, "missing.yaml"))
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadAndValidateManifests(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "env.yaml")
+	content := `apiVersion: kaito.sh/v1beta1
+kind: Workspace
+metadata:
+  name: phi
+inference:
+  preset:
+    name: phi-3.5-mini-instruct
+---
+apiVersion: kaito.sh/v1beta1
+kind: RAGEngine
+metadata:
+  name: rag
+---
+apiVersion: kaito.sh/v1beta1
+kind: Workspace
+metadata:
+  name: bogus
+inference:
+  preset:
+    name: not-a-real-model
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+`
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+
+	objects, err := loadManifests(file)
+	require.NoError(t, err)
+	require.Len(t, objects, 4)
+
+	models := fallbackModels()
+
+	gvr, err := validateManifest(objects[0], models)
+	assert.NoError(t, err)
+	assert.Equal(t, "workspaces", gvr.Resource)
+
+	gvr, err = validateManifest(objects[1], models)
+	assert.NoError(t, err)
+	assert.Equal(t, "ragengines", gvr.Resource)
+
+	_, err = validateManifest(objects[2], models)
+	assert.Error(t, err)
+
+	_, err = validateManifest(objects[3], models)
+	assert.Error(t, err)
+}
+
+// writeManifests writes name→content manifest files to a temp dir and returns the sorted file list
+func writeManifests(t *testing.T, manifests map[string]string) []string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range manifests {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	files, err := collectManifestFiles(dir)
+	require.NoError(t, err)
+	return files
+}
+
+const (
+	goodWorkspaceManifest = `apiVersion: kaito.sh/v1beta1
+kind: Workspace
+metadata:
+  name: phi
+inference:
+  preset:
+    name: phi-3.5-mini-instruct
+`
+	badKindManifest = `apiVersion: kaito.sh/v1beta1
+kind: Pod
+metadata:
+  name: bad
+`
+)
+
+// newWorkspaceListClient returns a fake dynamic client that can list workspaces
+func newWorkspaceListClient(gvr schema.GroupVersionResource) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "WorkspaceList"},
+	)
+}
+
+func TestDeployManifestFiles(t *testing.T) {
+	workspaceGVR := schema.GroupVersionResource{Group: "kaito.sh", Version: "v1beta1", Resource: "workspaces"}
+
+	// b-good.yaml sorts after a-bad.yaml, so any creation would happen after the bad file
+	files := writeManifests(t, map[string]string{
+		"a-bad.yaml":  badKindManifest,
+		"b-good.yaml": goodWorkspaceManifest,
+	})
+	require.Len(t, files, 2)
+
+	t.Run("Invalid manifest means nothing is created", func(t *testing.T) {
+		client := newWorkspaceListClient(workspaceGVR)
+		o := &DeployOptions{Namespace: "default"}
+
+		_, err := o.deployManifestFiles(client, files)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "1 of 2 manifests failed validation; nothing was created")
+
+		list, err := client.Resource(workspaceGVR).Namespace("default").List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, list.Items)
+	})
+
+	t.Run("Invalid manifest in a later file blocks earlier ones", func(t *testing.T) {
+		files := writeManifests(t, map[string]string{
+			"a-good.yaml": goodWorkspaceManifest,
+			"b-bad.yaml":  badKindManifest,
+		})
+		client := newWorkspaceListClient(workspaceGVR)
+		o := &DeployOptions{Namespace: "default"}
+
+		_, err := o.deployManifestFiles(client, files)
+		require.Error(t, err)
+
+		list, err := client.Resource(workspaceGVR).Namespace("default").List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, list.Items)
+	})
+
+	t.Run("Continue on error creates the valid manifests", func(t *testing.T) {
+		client := newWorkspaceListClient(workspaceGVR)
+		o := &DeployOptions{Namespace: "default", ContinueOnError: true}
+
+		results, err := o.deployManifestFiles(client, files)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Error(t, results[0].Err)
+		assert.NoError(t, results[1].Err)
+
+		_, err = client.Resource(workspaceGVR).Namespace("default").Get(context.TODO(), "phi", metav1.GetOptions{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Dry run with continue on error reports every file", func(t *testing.T) {
+		o := &DeployOptions{FromFile: filepath.Dir(files[0]), Namespace: "default", DryRun: true, ContinueOnError: true}
+		err := o.runFromFile()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "1 of 2 manifests failed")
+	})
+}
+
+func TestDeployManifestFilesFetchesCatalogOnce(t *testing.T) {
+	original := fetchSupportedModels
+	t.Cleanup(func() { fetchSupportedModels = original })
+
+	fetches := 0
+	fetchSupportedModels = func() ([]Model, error) {
+		fetches++
+		return []Model{{Name: "phi-3.5-mini-instruct"}, {Name: "phi-4"}}, nil
+	}
+
+	files := writeManifests(t, map[string]string{
+		"a.yaml": goodWorkspaceManifest,
+		"b.yaml": strings.Replace(goodWorkspaceManifest, "phi-3.5-mini-instruct", "phi4", 1),
+	})
+
+	o := &DeployOptions{Namespace: "default", DryRun: true}
+	results, err := o.deployManifestFiles(nil, files)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, 1, fetches)
+
+	t.Run("RAGEngine-only manifests skip the catalog", func(t *testing.T) {
+		fetches = 0
+		files := writeManifests(t, map[string]string{
+			"rag.yaml": "apiVersion: kaito.sh/v1beta1\nkind: RAGEngine\nmetadata:\n  name: rag\n",
+		})
+		_, err := o.deployManifestFiles(nil, files)
+		require.NoError(t, err)
+		assert.Equal(t, 0, fetches)
+	})
+
+	t.Run("No fallback surfaces a failed fetch", func(t *testing.T) {
+		fetchSupportedModels = func() ([]Model, error) {
+			return nil, fmt.Errorf("connection refused")
+		}
+		o := &DeployOptions{Namespace: "default", DryRun: true, NoFallback: true}
+		_, err := o.deployManifestFiles(nil, files)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--no-fallback")
+	})
+}
+
+func TestDeployFromFileRejectsDefaultedFlags(t *testing.T) {
+	for _, args := range [][]string{
+		{"--count", "2"},
+		{"--tuning-method", "lora"},
+		{"--wait-timeout", "1h"},
+	} {
+		t.Run(args[0], func(t *testing.T) {
+			cmd := NewDeployCmd(genericclioptions.NewConfigFlags(true))
+			cmd.SetArgs(append([]string{"--from-file", "./workspaces/"}, args...))
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "--from-file cannot be combined with "+args[0])
+		})
+	}
+}
+
+func TestDeployManifestFilesExisting(t *testing.T) {
+	files := writeManifests(t, map[string]string{"phi.yaml": goodWorkspaceManifest})
+
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newTTLWorkspace("default", "phi", ""))
+
+	o := &DeployOptions{Namespace: "default"}
+	results, err := o.deployManifestFiles(client, files)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.NoError(t, results[0].Err)
+	assert.True(t, results[0].Unchanged)
+
+	t.Run("TTL on an existing workspace is an error", func(t *testing.T) {
+		o := &DeployOptions{Namespace: "default", ttl: 2 * time.Hour}
+		results, err := o.deployManifestFiles(client, files)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Error(t, results[0].Err)
+		assert.Contains(t, results[0].Err.Error(), "--ttl only applies to newly created workspaces")
+
+		assert.Empty(t, getWorkspaceAnnotation(t, client, "default", "phi", expiresAtAnnotation))
+	})
+}
+
+func getWorkspaceAnnotation(t *testing.T, client *dynamicfake.FakeDynamicClient, namespace, name, key string) string {
+	t.Helper()
+
+	gvr := schema.GroupVersionResource{Group: "kaito.sh", Version: "v1beta1", Resource: "workspaces"}
+	workspace, err := client.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	require.NoError(t, err)
+	return workspace.GetAnnotations()[key]
+}
+
+func TestCreateWorkspaceExisting(t *testing.T) {
+	newClient := func() *dynamicfake.FakeDynamicClient {
+		return dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newTTLWorkspace("default", "phi", ""))
+	}
+
+	t.Run("Existing workspace without TTL is left alone", func(t *testing.T) {
+		client := newClient()
+		o := &DeployOptions{WorkspaceName: "phi", Model: "phi-3.5-mini-instruct", Namespace: "default"}
+		require.NoError(t, o.createWorkspace(context.TODO(), client))
+		assert.Empty(t, getWorkspaceAnnotation(t, client, "default", "phi", expiresAtAnnotation))
+	})
+
+	t.Run("TTL is not applied to an existing workspace", func(t *testing.T) {
+		client := newClient()
+		o := &DeployOptions{WorkspaceName: "phi", Model: "phi-3.5-mini-instruct", Namespace: "default", ttl: time.Hour}
+		err := o.createWorkspace(context.TODO(), client)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--ttl only applies to newly created workspaces")
+
+		assert.Empty(t, getWorkspaceAnnotation(t, client, "default", "phi", expiresAtAnnotation))
+	})
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// parseDurationFlag parses a duration flag value such as "30s", "5m", or "1h".
+// An empty value means the flag was not set and yields zero. Every duration flag
+// should go through this helper so bad input is reported the same way everywhere.
+func parseDurationFlag(flagName, value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --%s value '%s': expected a duration such as 30s, 5m, or 1h", flagName, value)
+	}
+	if duration < 0 {
+		return 0, fmt.Errorf("invalid --%s value '%s': duration must not be negative", flagName, value)
+	}
+
+	return duration, nil
+}
+
+// parsePositiveDurationFlag is parseDurationFlag for flags where an explicit zero is
+// meaningless, such as timeouts. An empty value still means unset and yields zero.
+func parsePositiveDurationFlag(flagName, value string) (time.Duration, error) {
+	duration, err := parseDurationFlag(flagName, value)
+	if err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(value) != "" && duration == 0 {
+		return 0, fmt.Errorf("invalid --%s value '%s': duration must be greater than 0", flagName, value)
+	}
+	return duration, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDurationFlag(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    time.Duration
+		expectError bool
+		errorMsg    string
+	}{
+		{name: "Empty value", value: "", expected: 0},
+		{name: "Seconds", value: "30s", expected: 30 * time.Second},
+		{name: "Minutes", value: "5m", expected: 5 * time.Minute},
+		{name: "Hours", value: "1h", expected: time.Hour},
+		{name: "Compound", value: "1h30m", expected: 90 * time.Minute},
+		{name: "Surrounding whitespace", value: " 10s ", expected: 10 * time.Second},
+		{name: "Missing unit", value: "30", expectError: true, errorMsg: "expected a duration"},
+		{name: "Unknown unit", value: "5x", expectError: true, errorMsg: "expected a duration"},
+		{name: "Days are not supported", value: "1d", expectError: true, errorMsg: "expected a duration"},
+		{name: "Not a number", value: "soon", expectError: true, errorMsg: "expected a duration"},
+		{name: "Negative", value: "-5m", expectError: true, errorMsg: "must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			duration, err := parseDurationFlag("since", tt.value)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "--since")
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, duration)
+			}
+		})
+	}
+}
+
+func TestParsePositiveDurationFlag(t *testing.T) {
+	duration, err := parsePositiveDurationFlag("request-timeout", "")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), duration)
+
+	duration, err = parsePositiveDurationFlag("request-timeout", "45s")
+	assert.NoError(t, err)
+	assert.Equal(t, 45*time.Second, duration)
+
+	for _, value := range []string{"0", "0s", "0m0s"} {
+		_, err := parsePositiveDurationFlag("request-timeout", value)
+		if assert.Error(t, err, value) {
+			assert.Contains(t, err.Error(), "--request-timeout")
+			assert.Contains(t, err.Error(), "must be greater than 0")
+		}
+	}
+
+	_, err = parsePositiveDurationFlag("request-timeout", "soon")
+	assert.Error(t, err)
+}
@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+)
+
+// GCOptions holds the options for the gc command
+type GCOptions struct {
+	configFlags *genericclioptions.ConfigFlags
+
+	Namespace     string
+	AllNamespaces bool
+	DryRun        bool
+}
+
+// NewGCCmd creates the gc command
+func NewGCCmd(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	o := &GCOptions{
+		configFlags: configFlags,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Delete Kaito workspaces whose TTL has expired",
+		Long: `Delete Kaito workspaces that are past their expiry time.
+
+Workspaces deployed with 'kubectl kaito deploy --ttl <duration>' carry a
+kaito.sh/expires-at annotation. This command deletes every workspace whose
+expiry time has passed, so short-lived test workspaces do not keep expensive
+GPU nodes running. Workspaces without the annotation are never touched.`,
+		Example: `  # Delete expired workspaces in the current namespace
+  kubectl kaito gc
+
+  # Preview which workspaces would be deleted across all namespaces
+  kubectl kaito gc --all-namespaces --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.validate(); err != nil {
+				klog.Errorf("Validation failed: %v", err)
+				return fmt.Errorf("validation failed: %w", err)
+			}
+			return o.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&o.Namespace, "namespace", "n", "", "Kubernetes namespace")
+	cmd.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", false, "Delete expired workspaces across all namespaces")
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", false, "Show which workspaces would be deleted without deleting them")
+
+	return cmd
+}
+
+func (o *GCOptions) validate() error {
+	klog.V(4).Info("Validating gc options")
+
+	if o.AllNamespaces && o.Namespace != "" {
+		return fmt.Errorf("cannot specify both --namespace and --all-namespaces")
+	}
+
+	klog.V(4).Info("GC validation completed successfully")
+	return nil
+}
+
+func (o *GCOptions) run() error {
+	klog.V(2).Info("Starting gc command")
+
+	// Get namespace
+	if !o.AllNamespaces {
+		o.Namespace = resolveNamespace(o.configFlags, o.Namespace)
+	}
+
+	// Get REST config
+	config, err := o.configFlags.ToRESTConfig()
+	if err != nil {
+		klog.Errorf("Failed to get REST config: %v", err)
+		return fmt.Errorf("failed to get REST config: %w", err)
+	}
+
+	// Create dynamic client
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		klog.Errorf("Failed to create dynamic client: %v", err)
+		return fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	return o.collectGarbage(context.TODO(), dynamicClient, time.Now())
+}
+
+// collectGarbage deletes every workspace whose expiry annotation is before now
+func (o *GCOptions) collectGarbage(ctx context.Context, dynamicClient dynamic.Interface, now time.Time) error {
+	gvr := schema.GroupVersionResource{
+		Group:    "kaito.sh",
+		Version:  "v1beta1",
+		Resource: "workspaces",
+	}
+
+	var workspaceList *unstructured.UnstructuredList
+	var err error
+
+	if o.AllNamespaces {
+		klog.V(4).Info("Listing workspaces across all namespaces")
+		workspaceList, err = dynamicClient.Resource(gvr).List(ctx, metav1.ListOptions{})
+	} else {
+		klog.V(4).Infof("Listing workspaces in namespace: %s", o.Namespace)
+		workspaceList, err = dynamicClient.Resource(gvr).Namespace(o.Namespace).List(ctx, metav1.ListOptions{})
+	}
+	if err != nil {
+		klog.Errorf("Failed to list workspaces: %v", err)
+		return fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	expired := findExpiredWorkspaces(workspaceList.Items, now)
+	if len(expired) == 0 {
+		fmt.Println("No expired workspaces found")
+		return nil
+	}
+
+	failed := 0
+	for _, workspace := range expired {
+		name, namespace := workspace.GetName(), workspace.GetNamespace()
+		expiresAt := workspace.GetAnnotations()[expiresAtAnnotation]
+
+		if o.DryRun {
+			fmt.Printf("Would delete workspace %s/%s (expired at %s)\n", namespace, name, expiresAt)
+			continue
+		}
+
+		klog.V(2).Infof("Deleting expired workspace %s/%s", namespace, name)
+		err := dynamicClient.Resource(gvr).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			klog.Errorf("Failed to delete workspace %s/%s: %v", namespace, name, err)
+			fmt.Printf("✗ Failed to delete workspace %s/%s: %v\n", namespace, name, err)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ Deleted workspace %s/%s (expired at %s)\n", namespace, name, expiresAt)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d expired workspaces", failed, len(expired))
+	}
+	return nil
+}
+
+// findExpiredWorkspaces returns the workspaces whose expiry annotation is before now.
+// Workspaces with a malformed annotation are skipped with a warning rather than deleted.
+func findExpiredWorkspaces(workspaces []unstructured.Unstructured, now time.Time) []unstructured.Unstructured {
+	var expired []unstructured.Unstructured
+	for _, workspace := range workspaces {
+		value, found := workspace.GetAnnotations()[expiresAtAnnotation]
+		if !found {
+			continue
+		}
+
+		expiresAt, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			klog.Warningf("Skipping workspace %s/%s: invalid %s annotation '%s'",
+				workspace.GetNamespace(), workspace.GetName(), expiresAtAnnotation, value)
+			continue
+		}
+
+		if expiresAt.Before(now) {
+			expired = append(expired, workspace)
+		}
+	}
+	return expired
+}
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func newTTLWorkspace(namespace, name, expiresAt string) *unstructured.Unstructured {
+	workspace := &unstructured.Unstructured{}
+	workspace.SetAPIVersion("kaito.sh/v1beta1")
+	workspace.SetKind("Workspace")
+	workspace.SetNamespace(namespace)
+	workspace.SetName(name)
+	if expiresAt != "" {
+		workspace.SetAnnotations(map[string]string{expiresAtAnnotation: expiresAt})
+	}
+	return workspace
+}
+
+func TestGCCmd(t *testing.T) {
+	configFlags := genericclioptions.NewConfigFlags(true)
+	cmd := NewGCCmd(configFlags)
+
+	t.Run("Command structure", func(t *testing.T) {
+		assert.Equal(t, "gc", cmd.Use)
+		assert.NotEmpty(t, cmd.Short)
+		assert.NotEmpty(t, cmd.Long)
+		assert.NotEmpty(t, cmd.Example)
+		assert.NotNil(t, cmd.RunE)
+	})
+
+	t.Run("Conflicting namespace options", func(t *testing.T) {
+		o := &GCOptions{Namespace: "default", AllNamespaces: true}
+		assert.Error(t, o.validate())
+	})
+}
+
+func TestFindExpiredWorkspaces(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	workspaces := []unstructured.Unstructured{
+		*newTTLWorkspace("default", "expired", "2025-01-01T11:00:00Z"),
+		*newTTLWorkspace("default", "live", "2025-01-01T13:00:00Z"),
+		*newTTLWorkspace("default", "no-ttl", ""),
+		*newTTLWorkspace("default", "malformed", "tomorrow"),
+	}
+
+	expired := findExpiredWorkspaces(workspaces, now)
+	require.Len(t, expired, 1)
+	assert.Equal(t, "expired", expired[0].GetName())
+}
+
+func TestCollectGarbage(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "kaito.sh", Version: "v1beta1", Resource: "workspaces"}
+	now := time.Now()
+	past := now.Add(-time.Hour).UTC().Format(time.RFC3339)
+	future := now.Add(time.Hour).UTC().Format(time.RFC3339)
+
+	newClient := func() *dynamicfake.FakeDynamicClient {
+		return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+			runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{gvr: "WorkspaceList"},
+			newTTLWorkspace("default", "expired", past),
+			newTTLWorkspace("default", "live", future),
+			newTTLWorkspace("other", "expired-elsewhere", past),
+		)
+	}
+
+	remaining := func(t *testing.T, client *dynamicfake.FakeDynamicClient) []string {
+		list, err := client.Resource(gvr).List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+		var names []string
+		for _, item := range list.Items {
+			names = append(names, item.GetName())
+		}
+		return names
+	}
+
+	t.Run("Deletes expired workspaces in namespace", func(t *testing.T) {
+		client := newClient()
+		o := &GCOptions{Namespace: "default"}
+		require.NoError(t, o.collectGarbage(context.TODO(), client, now))
+		assert.ElementsMatch(t, []string{"live", "expired-elsewhere"}, remaining(t, client))
+	})
+
+	t.Run("Deletes expired workspaces in all namespaces", func(t *testing.T) {
+		client := newClient()
+		o := &GCOptions{AllNamespaces: true}
+		require.NoError(t, o.collectGarbage(context.TODO(), client, now))
+		assert.ElementsMatch(t, []string{"live"}, remaining(t, client))
+	})
+
+	t.Run("Dry run deletes nothing", func(t *testing.T) {
+		client := newClient()
+		o := &GCOptions{AllNamespaces: true, DryRun: true}
+		require.NoError(t, o.collectGarbage(context.TODO(), client, now))
+		assert.Len(t, remaining(t, client), 3)
+	})
+}
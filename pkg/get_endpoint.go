@@ -107,14 +107,7 @@ func (o *GetEndpointOptions) run() error {
 	klog.V(2).Infof("Getting endpoint for workspace: %s", o.WorkspaceName)
 
 	// Get namespace
-	if o.Namespace == "" {
-		if ns, _, err := o.configFlags.ToRawKubeConfigLoader().Namespace(); err == nil && ns != "" {
-			o.Namespace = ns
-		} else {
-			klog.V(4).Info("No namespace specified, using 'default'")
-			o.Namespace = "default"
-		}
-	}
+	o.Namespace = resolveNamespace(o.configFlags, o.Namespace)
 
 	// Get REST config
 	config, err := o.configFlags.ToRESTConfig()
@@ -0,0 +1,292 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// workspaceLabelKey is the label Kaito sets on every pod belonging to a workspace
+const workspaceLabelKey = "kaito.sh/workspace"
+
+// LogsOptions holds the options for the logs command
+type LogsOptions struct {
+	configFlags *genericclioptions.ConfigFlags
+
+	WorkspaceName string
+	Namespace     string
+	Selector      string
+	Pod           string
+	Container     string
+	Since         string
+	TailLines     int64
+	Follow        bool
+
+	since time.Duration
+}
+
+// NewLogsCmd creates the logs command
+func NewLogsCmd(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	o := &LogsOptions{
+		configFlags: configFlags,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Stream logs from Kaito workspace pods",
+		Long: `Stream logs from the pods backing a Kaito workspace.
+
+By default logs are streamed from every pod labeled with the workspace name.
+Use --selector to narrow the pods with an additional label selector, or --pod
+to target a single pod (for example the rank-0 pod of a multi-node deployment).`,
+		Example: `  # Show logs from all pods of a workspace
+  kubectl kaito logs --workspace-name my-llama
+
+  # Follow logs from a single pod
+  kubectl kaito logs --workspace-name my-llama --pod my-llama-0 --follow
+
+  # Show only the last 10 minutes of logs
+  kubectl kaito logs --workspace-name my-llama --since 10m
+
+  # Only stream pods matching an extra label selector
+  kubectl kaito logs --workspace-name my-llama --selector apps.kubernetes.io/pod-index=0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.validate(); err != nil {
+				klog.Errorf("Validation failed: %v", err)
+				return fmt.Errorf("validation failed: %w", err)
+			}
+			return o.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&o.WorkspaceName, "workspace-name", "", "Name of the workspace (required)")
+	cmd.Flags().StringVarP(&o.Namespace, "namespace", "n", "", "Kubernetes namespace")
+	cmd.Flags().StringVarP(&o.Selector, "selector", "l", "", "Additional label selector ANDed with the workspace selector")
+	cmd.Flags().StringVar(&o.Pod, "pod", "", "Stream logs from a single workspace pod")
+	cmd.Flags().StringVarP(&o.Container, "container", "c", "", "Container name (defaults to the pod's only container)")
+	cmd.Flags().Int64Var(&o.TailLines, "tail", -1, "Number of recent lines to show per pod (-1 shows all)")
+	cmd.Flags().StringVar(&o.Since, "since", "", "Only return logs newer than a relative duration (e.g. 30s, 5m, 1h)")
+	cmd.Flags().BoolVarP(&o.Follow, "follow", "f", false, "Follow log output")
+
+	if err := cmd.MarkFlagRequired("workspace-name"); err != nil {
+		klog.Errorf("Failed to mark workspace-name flag as required: %v", err)
+	}
+
+	return cmd
+}
+
+func (o *LogsOptions) validate() error {
+	klog.V(4).Info("Validating logs options")
+
+	if o.WorkspaceName == "" {
+		return fmt.Errorf("workspace name is required")
+	}
+	if _, err := o.podSelector(); err != nil {
+		return err
+	}
+
+	since, err := parsePositiveDurationFlag("since", o.Since)
+	if err != nil {
+		return err
+	}
+	// The API server only accepts whole seconds and rejects sinceSeconds=0
+	if since > 0 && since < time.Second {
+		return fmt.Errorf("--since must be at least 1s")
+	}
+	o.since = since
+
+	klog.V(4).Info("Logs validation completed successfully")
+	return nil
+}
+
+// podSelector combines the workspace label selector with the user supplied --selector
+func (o *LogsOptions) podSelector() (labels.Selector, error) {
+	selector := labels.SelectorFromSet(labels.Set{workspaceLabelKey: o.WorkspaceName})
+	if o.Selector == "" {
+		return selector, nil
+	}
+
+	extra, err := labels.Parse(o.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --selector '%s': %w", o.Selector, err)
+	}
+
+	requirements, _ := extra.Requirements()
+	return selector.Add(requirements...), nil
+}
+
+func (o *LogsOptions) run() error {
+	klog.V(2).Infof("Streaming logs for workspace: %s", o.WorkspaceName)
+
+	// Get namespace
+	o.Namespace = resolveNamespace(o.configFlags, o.Namespace)
+
+	// Get REST config
+	config, err := o.configFlags.ToRESTConfig()
+	if err != nil {
+		klog.Errorf("Failed to get REST config: %v", err)
+		return fmt.Errorf("failed to get REST config: %w", err)
+	}
+
+	// Create kubernetes client
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		klog.Errorf("Failed to create kubernetes client: %v", err)
+		return fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+
+	pods, err := o.discoverPods(context.TODO(), clientset)
+	if err != nil {
+		return err
+	}
+
+	return o.streamLogs(context.TODO(), clientset, pods)
+}
+
+// discoverPods returns the workspace pods to stream, honoring --selector and --pod
+func (o *LogsOptions) discoverPods(ctx context.Context, clientset kubernetes.Interface) ([]corev1.Pod, error) {
+	selector, err := o.podSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Pod != "" {
+		klog.V(3).Infof("Getting pod %s", o.Pod)
+
+		pod, err := clientset.CoreV1().Pods(o.Namespace).Get(ctx, o.Pod, metav1.GetOptions{})
+		if err != nil {
+			klog.Errorf("Failed to get pod %s: %v", o.Pod, err)
+			return nil, fmt.Errorf("failed to get pod %s: %w", o.Pod, err)
+		}
+		if !selector.Matches(labels.Set(pod.Labels)) {
+			return nil, fmt.Errorf("pod %s does not match selector %s for workspace %s", o.Pod, selector, o.WorkspaceName)
+		}
+		return []corev1.Pod{*pod}, nil
+	}
+
+	klog.V(3).Infof("Listing pods with selector: %s", selector)
+
+	podList, err := clientset.CoreV1().Pods(o.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		klog.Errorf("Failed to list pods: %v", err)
+		return nil, fmt.Errorf("failed to list pods for workspace %s: %w", o.WorkspaceName, err)
+	}
+
+	if len(podList.Items) == 0 {
+		return nil, fmt.Errorf("no pods found for workspace %s matching selector %s", o.WorkspaceName, selector)
+	}
+
+	pods := podList.Items
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	return pods, nil
+}
+
+// streamLogs streams logs from all pods concurrently, prefixing each line with the pod name
+func (o *LogsOptions) streamLogs(ctx context.Context, clientset kubernetes.Interface, pods []corev1.Pod) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	prefix := len(pods) > 1
+
+	for i := range pods {
+		pod := pods[i]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := o.streamPodLogs(ctx, clientset, pod, prefix, &mu); err != nil {
+				mu.Lock()
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
+func (o *LogsOptions) streamPodLogs(ctx context.Context, clientset kubernetes.Interface, pod corev1.Pod, prefix bool, mu *sync.Mutex) error {
+	klog.V(3).Infof("Streaming logs from pod %s", pod.Name)
+
+	logOptions := &corev1.PodLogOptions{
+		Container: o.Container,
+		Follow:    o.Follow,
+	}
+	if o.TailLines >= 0 {
+		logOptions.TailLines = &o.TailLines
+	}
+	if o.since > 0 {
+		sinceSeconds := int64(math.Ceil(o.since.Seconds()))
+		logOptions.SinceSeconds = &sinceSeconds
+	}
+
+	stream, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		klog.Errorf("Failed to stream logs from pod %s: %v", pod.Name, err)
+		return fmt.Errorf("failed to stream logs from pod %s: %w", pod.Name, err)
+	}
+	defer stream.Close()
+
+	return copyLogLines(os.Stdout, stream, pod.Name, prefix, mu)
+}
+
+// copyLogLines copies log lines to out, optionally prefixed with the pod name
+func copyLogLines(out io.Writer, in io.Reader, podName string, prefix bool, mu *sync.Mutex) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		mu.Lock()
+		if prefix {
+			fmt.Fprintf(out, "[%s] %s\n", podName, scanner.Text())
+		} else {
+			fmt.Fprintln(out, scanner.Text())
+		}
+		mu.Unlock()
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read logs from pod %s: %w", podName, err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLogsCmd(t *testing.T) {
+	configFlags := genericclioptions.NewConfigFlags(true)
+	cmd := NewLogsCmd(configFlags)
+
+	t.Run("Command structure", func(t *testing.T) {
+		assert.Equal(t, "logs", cmd.Use)
+		assert.Contains(t, cmd.Short, "logs")
+		assert.NotEmpty(t, cmd.Long)
+		assert.NotEmpty(t, cmd.Example)
+		assert.NotNil(t, cmd.RunE)
+	})
+
+	t.Run("Flags present", func(t *testing.T) {
+		flags := cmd.Flags()
+
+		for _, flagName := range []string{"workspace-name", "namespace", "selector", "pod", "container", "tail", "since", "follow"} {
+			assert.NotNil(t, flags.Lookup(flagName), "Flag %s should be present", flagName)
+		}
+	})
+}
+
+func TestLogsOptionsValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     LogsOptions
+		expectError bool
+	}{
+		{
+			name:        "Valid options",
+			options:     LogsOptions{WorkspaceName: "test-workspace"},
+			expectError: false,
+		},
+		{
+			name:        "Valid selector",
+			options:     LogsOptions{WorkspaceName: "test-workspace", Selector: "role=leader,tier!=cache"},
+			expectError: false,
+		},
+		{
+			name:        "Valid since",
+			options:     LogsOptions{WorkspaceName: "test-workspace", Since: "15m"},
+			expectError: false,
+		},
+		{
+			name:        "Zero since",
+			options:     LogsOptions{WorkspaceName: "test-workspace", Since: "0s"},
+			expectError: true,
+		},
+		{
+			name:        "Sub-second since",
+			options:     LogsOptions{WorkspaceName: "test-workspace", Since: "400ms"},
+			expectError: true,
+		},
+		{
+			name:        "Malformed since",
+			options:     LogsOptions{WorkspaceName: "test-workspace", Since: "15 minutes"},
+			expectError: true,
+		},
+		{
+			name:        "Missing workspace name",
+			options:     LogsOptions{},
+			expectError: true,
+		},
+		{
+			name:        "Invalid selector",
+			options:     LogsOptions{WorkspaceName: "test-workspace", Selector: "role in (leader"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.options.validate()
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLogsDiscoverPods(t *testing.T) {
+	newPod := func(name string, podLabels map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: podLabels},
+		}
+	}
+
+	clientset := fake.NewSimpleClientset(
+		newPod("my-llama-1", map[string]string{workspaceLabelKey: "my-llama", "rank": "1"}),
+		newPod("my-llama-0", map[string]string{workspaceLabelKey: "my-llama", "rank": "0"}),
+		newPod("other-0", map[string]string{workspaceLabelKey: "other", "rank": "0"}),
+	)
+
+	podNames := func(pods []corev1.Pod) []string {
+		names := make([]string, len(pods))
+		for i, pod := range pods {
+			names[i] = pod.Name
+		}
+		return names
+	}
+
+	t.Run("All workspace pods", func(t *testing.T) {
+		o := &LogsOptions{WorkspaceName: "my-llama", Namespace: "default"}
+		pods, err := o.discoverPods(context.TODO(), clientset)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-llama-0", "my-llama-1"}, podNames(pods))
+	})
+
+	t.Run("Extra selector", func(t *testing.T) {
+		o := &LogsOptions{WorkspaceName: "my-llama", Namespace: "default", Selector: "rank=0"}
+		pods, err := o.discoverPods(context.TODO(), clientset)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-llama-0"}, podNames(pods))
+	})
+
+	t.Run("No matching pods", func(t *testing.T) {
+		o := &LogsOptions{WorkspaceName: "my-llama", Namespace: "default", Selector: "rank=5"}
+		_, err := o.discoverPods(context.TODO(), clientset)
+		assert.Error(t, err)
+	})
+
+	t.Run("Single pod", func(t *testing.T) {
+		o := &LogsOptions{WorkspaceName: "my-llama", Namespace: "default", Pod: "my-llama-1"}
+		pods, err := o.discoverPods(context.TODO(), clientset)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-llama-1"}, podNames(pods))
+	})
+
+	t.Run("Pod from another workspace", func(t *testing.T) {
+		o := &LogsOptions{WorkspaceName: "my-llama", Namespace: "default", Pod: "other-0"}
+		_, err := o.discoverPods(context.TODO(), clientset)
+		assert.Error(t, err)
+	})
+}
+
+func TestCopyLogLines(t *testing.T) {
+	var mu sync.Mutex
+
+	t.Run("With prefix", func(t *testing.T) {
+		var out bytes.Buffer
+		err := copyLogLines(&out, strings.NewReader("line one\nline two\n"), "my-llama-0", true, &mu)
+		require.NoError(t, err)
+		assert.Equal(t, "[my-llama-0] line one\n[my-llama-0] line two\n", out.String())
+	})
+
+	t.Run("Without prefix", func(t *testing.T) {
+		var out bytes.Buffer
+		err := copyLogLines(&out, strings.NewReader("line one\n"), "my-llama-0", false, &mu)
+		require.NoError(t, err)
+		assert.Equal(t, "line one\n", out.String())
+	})
+}
@@ -23,7 +23,9 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -72,6 +74,9 @@ type KaitoSupportedModelsResponse struct {
 	} `yaml:"models"`
 }
 
+// fetchSupportedModels fetches the upstream model catalog; tests replace it to simulate outages
+var fetchSupportedModels = fetchSupportedModelsFromKaito
+
 // fetchSupportedModelsFromKaito retrieves the official supported models from Kaito repository
 func fetchSupportedModelsFromKaito() ([]Model, error) {
 	klog.V(3).Info("Fetching supported models from official Kaito repository")
@@ -154,19 +159,32 @@ func fetchSupportedModelsFromKaito() ([]Model, error) {
 }
 
 // getSupportedModels returns supported models, first trying to fetch from official source,
-// falling back to hardcoded list if necessary
-func getSupportedModels() []Model {
+// falling back to hardcoded list if necessary. With noFallback set, a failed fetch is
+// returned as an error instead so strict pipelines never validate against a stale list.
+func getSupportedModels(noFallback bool) ([]Model, error) {
 	klog.V(4).Info("Getting supported models list")
 
 	// Try to fetch from official Kaito repository first
-	if models, err := fetchSupportedModelsFromKaito(); err == nil && len(models) > 0 {
+	models, err := fetchSupportedModels()
+	if err == nil && len(models) > 0 {
 		klog.V(3).Info("Using models from official Kaito repository")
-		return models
-	} else {
-		klog.Warningf("Failed to fetch from official repository, using fallback models: %v", err)
+		return models, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("official repository returned no models")
 	}
 
-	// Fallback to hardcoded models based on what we know from Kaito
+	if noFallback {
+		klog.Errorf("Failed to fetch from official repository: %v", err)
+		return nil, fmt.Errorf("failed to fetch supported models and --no-fallback is set: %w", err)
+	}
+
+	klog.Warningf("Failed to fetch from official repository, using fallback models: %v", err)
+	return fallbackModels(), nil
+}
+
+// fallbackModels returns the hardcoded models based on what we know from Kaito
+func fallbackModels() []Model {
 	return []Model{
 		{
 			Name:        "phi-3.5-mini-instruct",
@@ -267,22 +285,106 @@ func getSupportedModels() []Model {
 	}
 }
 
-// ValidateModelName checks if the provided model name is supported by Kaito
-func ValidateModelName(modelName string) error {
-	klog.V(4).Infof("Validating model name: %s", modelName)
+// modelAliases maps common short forms to candidate canonical preset names.
+// The first candidate present in the supported models list wins.
+var modelAliases = map[string][]string{
+	"llama2":      {"llama-2-7b"},
+	"llama2-7b":   {"llama-2-7b"},
+	"llama2-13b":  {"llama-2-13b"},
+	"llama2-70b":  {"llama-2-70b"},
+	"llama3":      {"llama-3.1-8b-instruct"},
+	"llama3.1":    {"llama-3.1-8b-instruct"},
+	"phi3.5":      {"phi-3.5-mini-instruct"},
+	"phi-3.5":     {"phi-3.5-mini-instruct"},
+	"phi3.5-mini": {"phi-3.5-mini-instruct"},
+	"phi4":        {"phi-4"},
+	"mistral":     {"mistral-7b-instruct", "mistral-7b"},
+	"falcon":      {"falcon-7b-instruct", "falcon-7b"},
+	"codellama":   {"codellama-7b"},
+}
 
-	if modelName == "" {
-		return fmt.Errorf("model name cannot be empty")
+// normalizeModelName lowercases a model name and strips separators so that
+// "Phi-3.5-Mini_Instruct" and "phi3.5-mini-instruct" compare equal
+func normalizeModelName(name string) string {
+	return strings.NewReplacer("-", "", "_", "", ".", "", " ", "").Replace(strings.ToLower(name))
+}
+
+// resolveModelAlias maps a model name or alias to a canonical preset name in models.
+// Exact names are authoritative, then the alias table, then a unique normalized match.
+func resolveModelAlias(modelName string, models []Model) (string, bool) {
+	names := make(map[string]bool, len(models))
+	for _, model := range models {
+		names[model.Name] = true
 	}
 
-	models := getSupportedModels()
+	if names[modelName] {
+		return modelName, true
+	}
+
+	for _, candidate := range modelAliases[strings.ToLower(modelName)] {
+		if names[candidate] {
+			return candidate, true
+		}
+	}
+
+	var matches []string
+	normalized := normalizeModelName(modelName)
 	for _, model := range models {
-		if model.Name == modelName {
-			klog.V(4).Infof("Model %s is valid", modelName)
-			return nil
+		if normalizeModelName(model.Name) == normalized {
+			matches = append(matches, model.Name)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0], true
+	}
+
+	return "", false
+}
+
+// ResolveModelName returns the canonical preset name for a model name or alias.
+// With noFallback set, validation fails if the live model catalog cannot be fetched.
+func ResolveModelName(modelName string, noFallback bool) (string, error) {
+	model, err := resolveModel(modelName, noFallback)
+	if err != nil {
+		return "", err
+	}
+	return model.Name, nil
+}
+
+// resolveModel returns the catalog entry for a model name or alias
+func resolveModel(modelName string, noFallback bool) (Model, error) {
+	klog.V(4).Infof("Resolving model name: %s", modelName)
+
+	if modelName == "" {
+		return Model{}, fmt.Errorf("model name cannot be empty")
+	}
+
+	models, err := getSupportedModels(noFallback)
+	if err != nil {
+		return Model{}, err
+	}
+	canonical, found := resolveModelAlias(modelName, models)
+	if !found {
+		return Model{}, unsupportedModelError(modelName, models)
+	}
+	if canonical != modelName {
+		klog.Infof("Using canonical model name '%s' for '%s'", canonical, modelName)
+	}
 
+	model, _ := findModel(models, canonical)
+	return model, nil
+}
+
+// ValidateModelName checks if the provided model name (or a known alias) is supported by Kaito
+func ValidateModelName(modelName string) error {
+	klog.V(4).Infof("Validating model name: %s", modelName)
+
+	_, err := ResolveModelName(modelName, false)
+	return err
+}
+
+// unsupportedModelError builds an error listing similar supported model names
+func unsupportedModelError(modelName string, models []Model) error {
 	// Generate suggestions for similar model names
 	suggestions := []string{}
 	lowerModelName := strings.ToLower(modelName)
@@ -303,6 +405,85 @@ func ValidateModelName(modelName string) error {
 	return fmt.Errorf("model '%s' is not supported by Kaito%s", modelName, suggestionText)
 }
 
+// findModel looks up a model by its exact name in models
+func findModel(models []Model, modelName string) (Model, bool) {
+	for _, model := range models {
+		if model.Name == modelName {
+			return model, true
+		}
+	}
+	return Model{}, false
+}
+
+// downloadSizePropertyKeys are the model properties that may carry the weight download size.
+// diskStorageRequirement is deliberately absent: it sizes the node disk, not the weights.
+var downloadSizePropertyKeys = []string{"downloadSize", "modelFileSize"}
+
+var (
+	parameterCountPattern = regexp.MustCompile(`(?:^|-)(\d+(?:\.\d+)?)b(?:-|$)`)
+	sizePattern           = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([MGT])(i?)B?$`)
+)
+
+// estimateModelDownloadSize returns an approximate model weight download size such as "~16GB".
+// It prefers sizes published in the model properties, then estimates from the parameter count
+// in the model name (fp16, 2 bytes per parameter), then falls back to the GPU memory requirement.
+// Every estimate is reported in GB. An empty string is returned when no estimate is possible.
+func estimateModelDownloadSize(model Model) string {
+	for _, key := range downloadSizePropertyKeys {
+		if size, ok := parseGigabytes(model.Properties[key]); ok {
+			return formatGigabytes(size)
+		}
+	}
+
+	if matches := parameterCountPattern.FindStringSubmatch(strings.ToLower(model.Name)); len(matches) > 1 {
+		if params, err := strconv.ParseFloat(matches[1], 64); err == nil && params > 0 {
+			return formatGigabytes(params * 2)
+		}
+	}
+
+	if size, ok := parseGigabytes(model.GPUMemory); ok {
+		return formatGigabytes(size)
+	}
+
+	return ""
+}
+
+// parseGigabytes converts a size such as "16GB", "15Gi", or "800Mi" to decimal gigabytes
+func parseGigabytes(value string) (float64, bool) {
+	matches := sizePattern.FindStringSubmatch(strings.TrimSpace(value))
+	if len(matches) < 4 {
+		return 0, false
+	}
+
+	size, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil || size <= 0 {
+		return 0, false
+	}
+
+	base := 1000.0
+	if matches[3] == "i" {
+		base = 1024.0
+	}
+
+	bytes := size
+	switch matches[2] {
+	case "M":
+		bytes *= base * base
+	case "G":
+		bytes *= base * base * base
+	case "T":
+		bytes *= base * base * base * base
+	}
+	return bytes / 1e9, true
+}
+
+func formatGigabytes(size float64) string {
+	if size < 10 {
+		return fmt.Sprintf("~%.1fGB", size)
+	}
+	return fmt.Sprintf("~%.0fGB", size)
+}
+
 // NewModelsCmd creates the models command with subcommands
 func NewModelsCmd(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
 	cmd := &cobra.Command{
@@ -351,6 +532,7 @@ func newModelsListCmd(configFlags *genericclioptions.ConfigFlags) *cobra.Command
 		sortBy     string
 		outputJSON bool
 		refresh    bool
+		noFallback bool
 	)
 
 	cmd := &cobra.Command{
@@ -379,9 +561,12 @@ Models are fetched from the official Kaito repository to ensure accuracy.`,
   kubectl kaito models list --output json
 
   # Force refresh from official repository
-  kubectl kaito models list --refresh`,
+  kubectl kaito models list --refresh
+
+  # Fail instead of using the built-in model list when the catalog is unreachable
+  kubectl kaito models list --no-fallback`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runModelsList(detailed, modelType, tags, sortBy, outputJSON, refresh)
+			return runModelsList(detailed, modelType, tags, sortBy, outputJSON, refresh, noFallback)
 		},
 	}
 
@@ -391,11 +576,14 @@ Models are fetched from the official Kaito repository to ensure accuracy.`,
 	cmd.Flags().StringVar(&sortBy, "sort-by", "name", "Sort by field (name, memory, nodes)")
 	cmd.Flags().BoolVar(&outputJSON, "output", false, "Output in JSON format")
 	cmd.Flags().BoolVar(&refresh, "refresh", false, "Force refresh from official Kaito repository")
+	cmd.Flags().BoolVar(&noFallback, "no-fallback", false, "Fail if the official model catalog cannot be fetched instead of using the built-in list")
 
 	return cmd
 }
 
 func newModelsDescribeCmd(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	var noFallback bool
+
 	cmd := &cobra.Command{
 		Use:   "describe <model-name>",
 		Short: "Describe a specific AI model",
@@ -411,21 +599,26 @@ func newModelsDescribeCmd(configFlags *genericclioptions.ConfigFlags) *cobra.Com
   kubectl kaito models describe llama-2-7b`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runModelsDescribe(args[0])
+			return runModelsDescribe(args[0], noFallback)
 		},
 	}
 
+	cmd.Flags().BoolVar(&noFallback, "no-fallback", false, "Fail if the official model catalog cannot be fetched instead of using the built-in list")
+
 	return cmd
 }
 
-func runModelsList(detailed bool, modelType string, tags []string, sortBy string, outputJSON bool, refresh bool) error {
+func runModelsList(detailed bool, modelType string, tags []string, sortBy string, outputJSON bool, refresh bool, noFallback bool) error {
 	klog.V(2).Info("Listing supported models")
 
 	if refresh {
 		fmt.Println("Refreshing models from official Kaito repository...")
 	}
 
-	models := getSupportedModels()
+	models, err := getSupportedModels(noFallback)
+	if err != nil {
+		return err
+	}
 
 	// Apply filters
 	if modelType != "" {
@@ -462,19 +655,24 @@ func runModelsList(detailed bool, modelType string, tags []string, sortBy string
 	return printModelsTable(models)
 }
 
-func runModelsDescribe(modelName string) error {
+func runModelsDescribe(modelName string, noFallback bool) error {
 	klog.V(2).Infof("Describing model: %s", modelName)
 
-	models := getSupportedModels()
+	models, err := getSupportedModels(noFallback)
+	if err != nil {
+		return err
+	}
 
-	for _, model := range models {
-		if model.Name == modelName {
-			return printModelDetail(model)
-		}
+	canonical, found := resolveModelAlias(modelName, models)
+	if !found {
+		return unsupportedModelError(modelName, models)
+	}
+	if canonical != modelName {
+		klog.Infof("Using canonical model name '%s' for '%s'", canonical, modelName)
 	}
 
-	// Use the validation function to provide helpful error message
-	return ValidateModelName(modelName)
+	model, _ := findModel(models, canonical)
+	return printModelDetail(model)
 }
 
 func filterModelsByType(models []Model, modelType string) []Model {
@@ -17,9 +17,11 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -80,7 +82,8 @@ func TestValidateModelName(t *testing.T) {
 
 func TestGetSupportedModels(t *testing.T) {
 	t.Run("Returns models", func(t *testing.T) {
-		models := getSupportedModels()
+		models, err := getSupportedModels(false)
+		require.NoError(t, err)
 		assert.NotEmpty(t, models)
 
 		// Check that models have required fields
@@ -92,6 +95,59 @@ func TestGetSupportedModels(t *testing.T) {
 	})
 }
 
+func TestGetSupportedModelsNoFallback(t *testing.T) {
+	original := fetchSupportedModels
+	t.Cleanup(func() { fetchSupportedModels = original })
+
+	t.Run("Catalog unreachable falls back by default", func(t *testing.T) {
+		fetchSupportedModels = func() ([]Model, error) {
+			return nil, fmt.Errorf("connection refused")
+		}
+
+		models, err := getSupportedModels(false)
+		require.NoError(t, err)
+		assert.Equal(t, fallbackModels(), models)
+	})
+
+	t.Run("Catalog unreachable fails with no fallback", func(t *testing.T) {
+		fetchSupportedModels = func() ([]Model, error) {
+			return nil, fmt.Errorf("connection refused")
+		}
+
+		_, err := getSupportedModels(true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--no-fallback")
+		assert.Contains(t, err.Error(), "connection refused")
+
+		_, err = ResolveModelName("phi-4", true)
+		assert.Error(t, err)
+	})
+
+	t.Run("Empty catalog fails with no fallback", func(t *testing.T) {
+		fetchSupportedModels = func() ([]Model, error) {
+			return nil, nil
+		}
+
+		_, err := getSupportedModels(true)
+		assert.Error(t, err)
+	})
+
+	t.Run("Live catalog is used with no fallback", func(t *testing.T) {
+		live := []Model{{Name: "phi-4", Type: "LLM", Runtime: "vllm"}}
+		fetchSupportedModels = func() ([]Model, error) {
+			return live, nil
+		}
+
+		models, err := getSupportedModels(true)
+		require.NoError(t, err)
+		assert.Equal(t, live, models)
+
+		model, err := ResolveModelName("phi4", true)
+		require.NoError(t, err)
+		assert.Equal(t, "phi-4", model)
+	})
+}
+
 func TestFilterModels(t *testing.T) {
 	models := []Model{
 		{Name: "model1", Type: "LLM"},
@@ -193,3 +249,121 @@ func TestExtractModelFamily(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimateModelDownloadSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    Model
+		expected string
+	}{
+		{
+			name: "Size from properties",
+			model: Model{
+				Name:       "llama-3.1-8b-instruct",
+				Properties: map[string]string{"downloadSize": "15Gi"},
+			},
+			expected: "~16GB",
+		},
+		{
+			name: "Disk storage requirement is not a download size",
+			model: Model{
+				Name:       "llama-3.1-8b-instruct",
+				Properties: map[string]string{"diskStorageRequirement": "90Gi"},
+			},
+			expected: "~16GB",
+		},
+		{
+			name: "Unparseable size property is skipped",
+			model: Model{
+				Name:       "phi-4",
+				Properties: map[string]string{"modelFileSize": "large"},
+			},
+			expected: "",
+		},
+		{
+			name:     "Size from parameter count",
+			model:    Model{Name: "llama-3.1-8b-instruct"},
+			expected: "~16GB",
+		},
+		{
+			name:     "Size from fractional parameter count",
+			model:    Model{Name: "qwen2.5-coder-1.5b-instruct"},
+			expected: "~3.0GB",
+		},
+		{
+			name:     "Size from GPU memory",
+			model:    Model{Name: "phi-3.5-mini-instruct", GPUMemory: "4GB"},
+			expected: "~4.0GB",
+		},
+		{
+			name:     "Binary GPU memory is reported in GB",
+			model:    Model{Name: "phi-3.5-mini-instruct", GPUMemory: "8Gi"},
+			expected: "~8.6GB",
+		},
+		{
+			name:     "Unknown size",
+			model:    Model{Name: "phi-4"},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, estimateModelDownloadSize(tt.model))
+		})
+	}
+}
+
+func TestResolveModelAlias(t *testing.T) {
+	models := []Model{
+		{Name: "llama-2-7b"},
+		{Name: "llama-3.1-8b-instruct"},
+		{Name: "phi-3.5-mini-instruct"},
+		{Name: "phi-4"},
+		{Name: "mistral-7b"},
+		{Name: "mistral-7b-instruct"},
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectHit bool
+	}{
+		{name: "Exact name", input: "phi-4", expected: "phi-4", expectHit: true},
+		{name: "Alias", input: "llama2", expected: "llama-2-7b", expectHit: true},
+		{name: "Alias is case-insensitive", input: "Phi3.5", expected: "phi-3.5-mini-instruct", expectHit: true},
+		{name: "Alias prefers first available candidate", input: "mistral", expected: "mistral-7b-instruct", expectHit: true},
+		{name: "Exact name wins over alias candidate", input: "mistral-7b", expected: "mistral-7b", expectHit: true},
+		{name: "Normalized name", input: "Llama_3.1_8B_Instruct", expected: "llama-3.1-8b-instruct", expectHit: true},
+		{name: "Alias target not in catalog", input: "falcon", expectHit: false},
+		{name: "Unknown model", input: "gpt-5", expectHit: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, found := resolveModelAlias(tt.input, models)
+			assert.Equal(t, tt.expectHit, found)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestResolveModelName(t *testing.T) {
+	t.Run("Empty name", func(t *testing.T) {
+		_, err := ResolveModelName("", false)
+		assert.Error(t, err)
+	})
+
+	t.Run("Alias resolves against supported models", func(t *testing.T) {
+		model, err := ResolveModelName("phi3.5", false)
+		assert.NoError(t, err)
+		assert.Equal(t, "phi-3.5-mini-instruct", model)
+	})
+
+	t.Run("Unsupported model", func(t *testing.T) {
+		_, err := ResolveModelName("definitely-not-a-model", false)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not supported by Kaito")
+	})
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/klog/v2"
+)
+
+// namespaceEnvVar overrides the kubeconfig context namespace for every command
+const namespaceEnvVar = "KAITO_NAMESPACE"
+
+// resolveNamespace returns the namespace a command should operate in. The order is:
+// the command's own --namespace flag, the global --namespace flag, KAITO_NAMESPACE,
+// the namespace of the current kubeconfig context, and finally "default".
+func resolveNamespace(configFlags *genericclioptions.ConfigFlags, explicit string) string {
+	if explicit != "" {
+		return explicit
+	}
+
+	if configFlags != nil && configFlags.Namespace != nil && *configFlags.Namespace != "" {
+		return *configFlags.Namespace
+	}
+
+	if ns := os.Getenv(namespaceEnvVar); ns != "" {
+		klog.V(4).Infof("Using namespace '%s' from %s", ns, namespaceEnvVar)
+		return ns
+	}
+
+	if configFlags != nil {
+		if ns, _, err := configFlags.ToRawKubeConfigLoader().Namespace(); err == nil && ns != "" {
+			klog.V(4).Infof("Using namespace '%s' from kubeconfig context", ns)
+			return ns
+		}
+	}
+
+	klog.V(4).Info("No namespace specified, using 'default'")
+	return "default"
+}
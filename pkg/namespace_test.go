@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// newTestConfigFlags returns config flags backed by a kubeconfig whose current
+// context uses contextNamespace (or no namespace when it is empty)
+func newTestConfigFlags(t *testing.T, contextNamespace string) *genericclioptions.ConfigFlags {
+	t.Helper()
+
+	kubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://127.0.0.1:6443
+users:
+- name: test
+  user:
+    token: test
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+`
+	if contextNamespace != "" {
+		kubeconfig += "    namespace: " + contextNamespace + "\n"
+	}
+	kubeconfig += "current-context: test\n"
+
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	require.NoError(t, os.WriteFile(path, []byte(kubeconfig), 0o600))
+
+	configFlags := genericclioptions.NewConfigFlags(true)
+	configFlags.KubeConfig = &path
+	return configFlags
+}
+
+func TestResolveNamespace(t *testing.T) {
+	tests := []struct {
+		name             string
+		explicit         string
+		globalFlag       string
+		env              string
+		contextNamespace string
+		expected         string
+	}{
+		{
+			name:             "Command flag wins over everything",
+			explicit:         "cmd-ns",
+			globalFlag:       "global-ns",
+			env:              "env-ns",
+			contextNamespace: "ctx-ns",
+			expected:         "cmd-ns",
+		},
+		{
+			name:             "Global flag wins over environment and context",
+			globalFlag:       "global-ns",
+			env:              "env-ns",
+			contextNamespace: "ctx-ns",
+			expected:         "global-ns",
+		},
+		{
+			name:             "Environment wins over context",
+			env:              "env-ns",
+			contextNamespace: "ctx-ns",
+			expected:         "env-ns",
+		},
+		{
+			name:             "Context namespace",
+			contextNamespace: "ctx-ns",
+			expected:         "ctx-ns",
+		},
+		{
+			name:     "Falls back to default",
+			expected: "default",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(namespaceEnvVar, tt.env)
+
+			configFlags := newTestConfigFlags(t, tt.contextNamespace)
+			configFlags.Namespace = &tt.globalFlag
+
+			assert.Equal(t, tt.expected, resolveNamespace(configFlags, tt.explicit))
+		})
+	}
+
+	t.Run("Nil config flags", func(t *testing.T) {
+		t.Setenv(namespaceEnvVar, "")
+		assert.Equal(t, "default", resolveNamespace(nil, ""))
+	})
+}
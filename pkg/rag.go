@@ -23,6 +23,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -142,6 +144,7 @@ func newRagQueryCmd(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
 		temperature float64
 		format      string
 		interactive bool
+		showLatency bool
 	)
 
 	cmd := &cobra.Command{
@@ -161,13 +164,16 @@ based on the indexed knowledge base.`,
   kubectl kaito rag query --name my-rag --question "Explain neural networks" --top-k 5 --temperature 0.3
 
   # JSON output format
-  kubectl kaito rag query --name my-rag --question "What is AI?" --format json`,
+  kubectl kaito rag query --name my-rag --question "What is AI?" --format json
+
+  # Report round-trip latency and the retrieval/generation split
+  kubectl kaito rag query --name my-rag --question "What is AI?" --show-latency`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := validateRagQueryOptions(ragName, question, interactive); err != nil {
+			if err := validateRagQueryOptions(ragName, question, interactive, showLatency); err != nil {
 				klog.Errorf("Validation failed: %v", err)
 				return fmt.Errorf("validation failed: %w", err)
 			}
-			return runRagQuery(configFlags, ragName, namespace, question, topK, temperature, format, interactive)
+			return runRagQuery(configFlags, ragName, namespace, question, topK, temperature, format, interactive, showLatency)
 		},
 	}
 
@@ -178,6 +184,7 @@ based on the indexed knowledge base.`,
 	cmd.Flags().Float64Var(&temperature, "temperature", 0.7, "Temperature for generation")
 	cmd.Flags().StringVar(&format, "format", "text", "Output format (text, json)")
 	cmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "Interactive query mode")
+	cmd.Flags().BoolVar(&showLatency, "show-latency", false, "Report query latency and the retrieval/generation split when available (single queries only)")
 
 	if err := cmd.MarkFlagRequired("name"); err != nil {
 		klog.Errorf("Failed to mark name flag as required: %v", err)
@@ -207,7 +214,7 @@ func validateRagDeployOptions(ragName, vectorDB, indexService string) error {
 	return nil
 }
 
-func validateRagQueryOptions(ragName, question string, interactive bool) error {
+func validateRagQueryOptions(ragName, question string, interactive, showLatency bool) error {
 	klog.V(4).Info("Validating RAG query options")
 
 	if ragName == "" {
@@ -218,6 +225,10 @@ func validateRagQueryOptions(ragName, question string, interactive bool) error {
 		return fmt.Errorf("question is required in non-interactive mode")
 	}
 
+	if interactive && showLatency {
+		return fmt.Errorf("--show-latency is only supported for single queries, not --interactive")
+	}
+
 	klog.V(4).Info("RAG query validation completed successfully")
 	return nil
 }
@@ -228,14 +239,7 @@ func runRagDeploy(configFlags *genericclioptions.ConfigFlags, ragName, namespace
 	klog.V(2).Infof("Deploying RAG engine: %s", ragName)
 
 	// Get namespace
-	if namespace == "" {
-		if ns, _, err := configFlags.ToRawKubeConfigLoader().Namespace(); err == nil {
-			namespace = ns
-		} else {
-			klog.V(4).Info("No namespace specified, using 'default'")
-			namespace = "default"
-		}
-	}
+	namespace = resolveNamespace(configFlags, namespace)
 
 	if dryRun {
 		return showRagDeployDryRun(ragName, namespace, vectorDB, indexService, embeddingModel, dataSource,
@@ -285,18 +289,11 @@ func runRagDeploy(configFlags *genericclioptions.ConfigFlags, ragName, namespace
 }
 
 func runRagQuery(configFlags *genericclioptions.ConfigFlags, ragName, namespace, question string,
-	topK int, temperature float64, format string, interactive bool) error {
+	topK int, temperature float64, format string, interactive, showLatency bool) error {
 	klog.V(2).Infof("Querying RAG engine: %s", ragName)
 
 	// Get namespace
-	if namespace == "" {
-		if ns, _, err := configFlags.ToRawKubeConfigLoader().Namespace(); err == nil {
-			namespace = ns
-		} else {
-			klog.V(4).Info("No namespace specified, using 'default'")
-			namespace = "default"
-		}
-	}
+	namespace = resolveNamespace(configFlags, namespace)
 
 	// Get REST config
 	config, err := configFlags.ToRESTConfig()
@@ -326,13 +323,19 @@ func runRagQuery(configFlags *genericclioptions.ConfigFlags, ragName, namespace,
 	}
 
 	// Single query mode
+	start := time.Now()
 	response, err := sendRagQuery(endpoint, question, topK, temperature)
 	if err != nil {
 		klog.Errorf("Failed to send query: %v", err)
 		return fmt.Errorf("failed to send query: %w", err)
 	}
+	latency := ragQueryLatency(response, time.Since(start))
+	klog.V(3).Infof("RAG query completed in %dms", latency.TotalMs)
 
 	if format == "json" {
+		if showLatency {
+			response["latency"] = latency
+		}
 		jsonOutput, err := json.MarshalIndent(response, "", "  ")
 		if err != nil {
 			klog.Errorf("Failed to marshal JSON response: %v", err)
@@ -345,11 +348,72 @@ func runRagQuery(configFlags *genericclioptions.ConfigFlags, ragName, namespace,
 		} else {
 			return fmt.Errorf("invalid response format")
 		}
+		if showLatency {
+			printRagLatency(os.Stdout, latency)
+		}
+	}
+
+	return nil
+}
+
+// ragLatency is the timing breakdown reported by --show-latency
+type ragLatency struct {
+	RetrievalMs  *int64 `json:"retrievalMs,omitempty"`
+	GenerationMs *int64 `json:"generationMs,omitempty"`
+	TotalMs      int64  `json:"totalMs"`
+}
+
+// ragQueryLatency builds the latency breakdown from the measured round trip and any
+// timing fields the RAG engine returned, either at the top level or under "timings".
+// Fields ending in _ms are milliseconds; *_time and *_seconds fields are seconds.
+func ragQueryLatency(response map[string]interface{}, total time.Duration) ragLatency {
+	latency := ragLatency{TotalMs: total.Milliseconds()}
+
+	sources := []map[string]interface{}{response}
+	if timings, ok := response["timings"].(map[string]interface{}); ok {
+		sources = append([]map[string]interface{}{timings}, sources...)
 	}
 
+	latency.RetrievalMs = findTimingMs(sources, "retrieval")
+	latency.GenerationMs = findTimingMs(sources, "generation")
+	return latency
+}
+
+// findTimingMs returns the first numeric timing field for the given stage, in milliseconds
+func findTimingMs(sources []map[string]interface{}, stage string) *int64 {
+	units := []struct {
+		suffix string
+		scale  float64
+	}{
+		{"_ms", 1},
+		{"_time", 1000},
+		{"_seconds", 1000},
+	}
+
+	for _, source := range sources {
+		for _, unit := range units {
+			if value, ok := source[stage+unit.suffix].(float64); ok && value >= 0 {
+				ms := int64(value * unit.scale)
+				return &ms
+			}
+		}
+	}
 	return nil
 }
 
+// printRagLatency prints the latency breakdown after a text-mode answer
+func printRagLatency(out io.Writer, latency ragLatency) {
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "Latency: %dms total", latency.TotalMs)
+	if latency.RetrievalMs != nil {
+		fmt.Fprintf(out, ", retrieval %dms", *latency.RetrievalMs)
+	}
+	if latency.GenerationMs != nil {
+		fmt.Fprintf(out, ", generation %dms", *latency.GenerationMs)
+	}
+	fmt.Fprintln(out)
+}
+
 func buildRAGEngine(ragName, namespace, vectorDB, indexService, embeddingModel, dataSource string,
 	chunkSize, chunkOverlap int, accessMode, accessSecret, storageSize, storageClass string) *unstructured.Unstructured {
 	klog.V(4).Info("Building RAGEngine configuration")
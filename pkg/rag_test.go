@@ -17,7 +17,9 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -151,6 +153,7 @@ func TestNewRagQueryCmd(t *testing.T) {
 			"question",
 			"interactive",
 			"temperature",
+			"show-latency",
 		}
 
 		for _, flagName := range optionalFlags {
@@ -245,6 +248,7 @@ func TestValidateRagQueryOptions(t *testing.T) {
 		ragName     string
 		question    string
 		interactive bool
+		showLatency bool
 		expectError bool
 		errorMsg    string
 	}{
@@ -285,11 +289,26 @@ func TestValidateRagQueryOptions(t *testing.T) {
 			interactive: true,
 			expectError: false,
 		},
+		{
+			name:        "Show latency with single query",
+			ragName:     "test-rag",
+			question:    "What is AI?",
+			showLatency: true,
+			expectError: false,
+		},
+		{
+			name:        "Show latency with interactive mode",
+			ragName:     "test-rag",
+			interactive: true,
+			showLatency: true,
+			expectError: true,
+			errorMsg:    "--show-latency is only supported for single queries",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateRagQueryOptions(tt.ragName, tt.question, tt.interactive)
+			err := validateRagQueryOptions(tt.ragName, tt.question, tt.interactive, tt.showLatency)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -438,3 +457,59 @@ func TestContainsHelper(t *testing.T) {
 		})
 	}
 }
+
+func TestRagQueryLatency(t *testing.T) {
+	t.Run("Total only", func(t *testing.T) {
+		latency := ragQueryLatency(map[string]interface{}{"answer": "42"}, 1500*time.Millisecond)
+		assert.Equal(t, int64(1500), latency.TotalMs)
+		assert.Nil(t, latency.RetrievalMs)
+		assert.Nil(t, latency.GenerationMs)
+	})
+
+	t.Run("Millisecond fields under timings", func(t *testing.T) {
+		response := map[string]interface{}{
+			"answer": "42",
+			"timings": map[string]interface{}{
+				"retrieval_ms":  float64(120),
+				"generation_ms": float64(880),
+			},
+		}
+		latency := ragQueryLatency(response, time.Second)
+		require.NotNil(t, latency.RetrievalMs)
+		require.NotNil(t, latency.GenerationMs)
+		assert.Equal(t, int64(120), *latency.RetrievalMs)
+		assert.Equal(t, int64(880), *latency.GenerationMs)
+	})
+
+	t.Run("Top-level seconds fields", func(t *testing.T) {
+		response := map[string]interface{}{
+			"answer":          "42",
+			"retrieval_time":  0.25,
+			"generation_time": 1.5,
+		}
+		latency := ragQueryLatency(response, 2*time.Second)
+		require.NotNil(t, latency.RetrievalMs)
+		require.NotNil(t, latency.GenerationMs)
+		assert.Equal(t, int64(250), *latency.RetrievalMs)
+		assert.Equal(t, int64(1500), *latency.GenerationMs)
+	})
+
+	t.Run("Non-numeric fields are ignored", func(t *testing.T) {
+		response := map[string]interface{}{"retrieval_ms": "fast"}
+		latency := ragQueryLatency(response, time.Second)
+		assert.Nil(t, latency.RetrievalMs)
+	})
+}
+
+func TestPrintRagLatency(t *testing.T) {
+	retrieval, generation := int64(120), int64(880)
+
+	var out bytes.Buffer
+	printRagLatency(&out, ragLatency{TotalMs: 1010, RetrievalMs: &retrieval, GenerationMs: &generation})
+	assert.Contains(t, out.String(), "Latency: 1010ms total, retrieval 120ms, generation 880ms")
+
+	out.Reset()
+	printRagLatency(&out, ragLatency{TotalMs: 500})
+	assert.Contains(t, out.String(), "Latency: 500ms total\n")
+	assert.NotContains(t, out.String(), "retrieval")
+}
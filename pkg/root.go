@@ -71,6 +71,8 @@ in Kubernetes clusters through Kaito workspaces.`,
 	cmd.AddCommand(NewGetEndpointCmd(configFlags))
 	cmd.AddCommand(NewChatCmd(configFlags))
 	cmd.AddCommand(NewModelsCmd(configFlags))
+	cmd.AddCommand(NewLogsCmd(configFlags))
+	cmd.AddCommand(NewGCCmd(configFlags))
 	// cmd.AddCommand(NewRagCmd(configFlags)) // Hidden until RAGEngine CRD is available
 
 	return cmd
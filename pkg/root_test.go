@@ -96,6 +96,8 @@ func TestRootCmdSubcommands(t *testing.T) {
 		"get-endpoint",
 		"chat",
 		"models",
+		"logs",
+		"gc",
 		// Note: "rag" is commented out in root.go
 	}
 
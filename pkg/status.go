@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -34,12 +35,16 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// workspaceSucceededCondition is the condition Kaito sets once a workspace is fully ready
+const workspaceSucceededCondition = "WorkspaceSucceeded"
+
 // StatusOptions holds the options for the status command
 type StatusOptions struct {
 	configFlags *genericclioptions.ConfigFlags
 
 	WorkspaceName   string
 	Namespace       string
+	SortBy          string
 	AllNamespaces   bool
 	ShowConditions  bool
 	ShowWorkerNodes bool
@@ -68,6 +73,9 @@ readiness conditions, resource allocation, and deployment status.`,
   # Check status across all namespaces
   kubectl kaito status --all-namespaces
 
+  # List workspaces across all namespaces, oldest first
+  kubectl kaito status --all-namespaces --sort-by age
+
   # Watch for changes in real-time
   kubectl kaito status --workspace-name my-workspace --watch
 
@@ -88,6 +96,7 @@ readiness conditions, resource allocation, and deployment status.`,
 	cmd.Flags().BoolVar(&o.ShowConditions, "show-conditions", false, "Show detailed status conditions")
 	cmd.Flags().BoolVar(&o.ShowWorkerNodes, "show-worker-nodes", false, "Show worker node information")
 	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", false, "Watch for changes in real-time")
+	cmd.Flags().StringVar(&o.SortBy, "sort-by", "name", "Sort the workspace list by field (name, age, namespace, ready)")
 
 	return cmd
 }
@@ -99,6 +108,12 @@ func (o *StatusOptions) validate() error {
 		return fmt.Errorf("cannot specify both --namespace and --all-namespaces")
 	}
 
+	switch o.SortBy {
+	case "", "name", "age", "namespace", "ready":
+	default:
+		return fmt.Errorf("invalid --sort-by value '%s', must be one of: name, age, namespace, ready", o.SortBy)
+	}
+
 	klog.V(4).Info("Status command validation completed successfully")
 	return nil
 }
@@ -121,13 +136,8 @@ func (o *StatusOptions) run() error {
 	}
 
 	// Get namespace
-	if o.Namespace == "" && !o.AllNamespaces {
-		if ns, _, err := o.configFlags.ToRawKubeConfigLoader().Namespace(); err == nil && ns != "" {
-			o.Namespace = ns
-		} else {
-			klog.V(4).Info("No namespace specified, using 'default'")
-			o.Namespace = "default"
-		}
+	if !o.AllNamespaces {
+		o.Namespace = resolveNamespace(o.configFlags, o.Namespace)
 	}
 
 	// Handle watch mode for specific workspace
@@ -206,10 +216,50 @@ func (o *StatusOptions) listWorkspaces(dynamicClient dynamic.Interface) error {
 		return nil
 	}
 
+	o.sortWorkspaces(workspaceList.Items)
 	o.printWorkspaceTable(workspaceList.Items)
 	return nil
 }
 
+// sortWorkspaces orders workspaces by the --sort-by field, breaking ties by namespace and name
+func (o *StatusOptions) sortWorkspaces(workspaces []unstructured.Unstructured) {
+	klog.V(4).Infof("Sorting workspaces by: %s", o.SortBy)
+
+	byNamespacedName := func(a, b *unstructured.Unstructured) bool {
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	}
+
+	sort.SliceStable(workspaces, func(i, j int) bool {
+		a, b := &workspaces[i], &workspaces[j]
+
+		switch o.SortBy {
+		case "age":
+			// Oldest first
+			aTime, bTime := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+			if !aTime.Equal(&bTime) {
+				return aTime.Before(&bTime)
+			}
+		case "namespace":
+			return byNamespacedName(a, b)
+		case "ready":
+			// Not-ready workspaces first
+			aReady, bReady := isWorkspaceSucceeded(a), isWorkspaceSucceeded(b)
+			if aReady != bReady {
+				return !aReady
+			}
+		default:
+			if a.GetName() != b.GetName() {
+				return a.GetName() < b.GetName()
+			}
+		}
+
+		return byNamespacedName(a, b)
+	})
+}
+
 func (o *StatusOptions) watchWorkspace(dynamicClient dynamic.Interface) error {
 	klog.V(2).Infof("Starting watch for workspace: %s", o.WorkspaceName)
 	fmt.Printf("Watching workspace %s for changes (Ctrl+C to stop)...\n", o.WorkspaceName)
@@ -257,7 +307,7 @@ func (o *StatusOptions) printWorkspaceTable(workspaces []unstructured.Unstructur
 		nodeClaimName := o.getNodeClaimName(&workspace)
 		resourceReady := o.getConditionStatus(&workspace, "ResourceReady")
 		inferenceReady := o.getConditionStatus(&workspace, "InferenceReady")
-		workspaceReady := o.getConditionStatus(&workspace, "WorkspaceReady")
+		workspaceReady := o.getConditionStatus(&workspace, workspaceSucceededCondition)
 		age := o.getAge(&workspace)
 
 		if o.AllNamespaces {
@@ -422,7 +472,7 @@ func (o *StatusOptions) extractConditionStatuses(condList []interface{}) (string
 				resourceReady = condStatus
 			case "InferenceReady":
 				inferenceReady = condStatus
-			case "WorkspaceSucceeded":
+			case workspaceSucceededCondition:
 				workspaceReady = condStatus
 			}
 		}
@@ -613,6 +663,11 @@ func extractNodeClaimFromText(text string) string {
 	return ""
 }
 
+// isWorkspaceSucceeded reports whether Kaito has marked the workspace as ready
+func isWorkspaceSucceeded(workspace *unstructured.Unstructured) bool {
+	return workspaceConditions(workspace)[workspaceSucceededCondition] == "True"
+}
+
 func (o *StatusOptions) getConditionStatus(workspace *unstructured.Unstructured, conditionType string) string {
 	conditions, found, err := unstructured.NestedSlice(workspace.Object, "status", "conditions")
 	if err != nil || !found {
@@ -65,6 +65,20 @@ func TestStatusOptionsValidation(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "Valid sort field",
+			options: StatusOptions{
+				SortBy: "ready",
+			},
+			expectError: false,
+		},
+		{
+			name: "Invalid sort field",
+			options: StatusOptions{
+				SortBy: "size",
+			},
+			expectError: true,
+		},
 		{
 			name: "Conflicting namespace options",
 			options: StatusOptions{
@@ -170,6 +184,58 @@ func TestGetConditionStatus(t *testing.T) {
 	}
 }
 
+func TestSortWorkspaces(t *testing.T) {
+	now := time.Now()
+	newWorkspace := func(namespace, name string, age time.Duration, ready string) unstructured.Unstructured {
+		return unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":              name,
+					"namespace":         namespace,
+					"creationTimestamp": now.Add(-age).Format(time.RFC3339),
+				},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{
+							"type":   "WorkspaceSucceeded",
+							"status": ready,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		sortBy   string
+		expected []string
+	}{
+		{sortBy: "name", expected: []string{"alpha", "bravo", "charlie"}},
+		{sortBy: "age", expected: []string{"charlie", "alpha", "bravo"}},
+		{sortBy: "namespace", expected: []string{"bravo", "alpha", "charlie"}},
+		{sortBy: "ready", expected: []string{"alpha", "charlie", "bravo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sortBy, func(t *testing.T) {
+			workspaces := []unstructured.Unstructured{
+				newWorkspace("team-b", "charlie", 3*time.Hour, "False"),
+				newWorkspace("team-a", "bravo", time.Minute, "True"),
+				newWorkspace("team-b", "alpha", time.Hour, "False"),
+			}
+
+			options := &StatusOptions{SortBy: tt.sortBy}
+			options.sortWorkspaces(workspaces)
+
+			names := make([]string, len(workspaces))
+			for i := range workspaces {
+				names[i] = workspaces[i].GetName()
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
 func TestGetAge(t *testing.T) {
 	now := time.Now()
 	oneHourAgo := now.Add(-time.Hour)
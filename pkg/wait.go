@@ -0,0 +1,212 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// WaitOutputText prints human-readable progress while waiting
+	WaitOutputText = "text"
+	// WaitOutputJSON prints one JSON status line per poll for CI systems
+	WaitOutputJSON = "json"
+)
+
+// waitPollInterval is how often the workspace status is polled while waiting
+var waitPollInterval = 10 * time.Second
+
+// waitProgress is a single progress report emitted while waiting for a workspace
+type waitProgress struct {
+	Conditions     map[string]string `json:"conditions"`
+	Workspace      string            `json:"workspace"`
+	Namespace      string            `json:"namespace"`
+	Phase          string            `json:"phase"`
+	Elapsed        string            `json:"elapsed"`
+	ElapsedSeconds int64             `json:"elapsedSeconds"`
+	Error          string            `json:"error,omitempty"`
+}
+
+// validateWaitOutput checks the --wait-output value
+func validateWaitOutput(output string) error {
+	if output != WaitOutputText && output != WaitOutputJSON {
+		return fmt.Errorf("invalid --wait-output value '%s', must be '%s' or '%s'", output, WaitOutputText, WaitOutputJSON)
+	}
+	return nil
+}
+
+// waitForWorkspaceReady polls a workspace until it succeeds or the timeout expires,
+// reporting progress to out in the requested output format.
+func waitForWorkspaceReady(ctx context.Context, dynamicClient dynamic.Interface, namespace, name string,
+	timeout time.Duration, output string, out io.Writer) error {
+	klog.V(2).Infof("Waiting up to %s for workspace %s/%s to become ready", timeout, namespace, name)
+
+	gvr := schema.GroupVersionResource{
+		Group:    "kaito.sh",
+		Version:  "v1beta1",
+		Resource: "workspaces",
+	}
+
+	start := time.Now()
+	lastPhase := ""
+	lineOpen := false
+	var lastErr error
+
+	if output == WaitOutputText {
+		fmt.Fprintf(out, "Waiting for workspace %s to become ready (timeout %s)\n", name, timeout)
+	}
+
+	err := wait.PollUntilContextTimeout(ctx, waitPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		workspace, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if isPermanentWaitError(err) {
+				klog.Errorf("Failed to get workspace %s: %v", name, err)
+				return false, fmt.Errorf("failed to get workspace %s: %w", name, err)
+			}
+			// A single failed poll during a long wait should not abort it
+			klog.Warningf("Failed to get workspace %s, retrying: %v", name, err)
+			lastErr = err
+			if output == WaitOutputJSON {
+				// Keep the last known phase so consumers see the poll failed, not the workspace
+				return false, writeWaitProgress(out, waitProgress{
+					Workspace:      name,
+					Namespace:      namespace,
+					Phase:          lastPhase,
+					Conditions:     map[string]string{},
+					Elapsed:        time.Since(start).Round(time.Second).String(),
+					ElapsedSeconds: int64(time.Since(start).Seconds()),
+					Error:          err.Error(),
+				})
+			}
+			return false, nil
+		}
+		lastErr = nil
+
+		conditions := workspaceConditions(workspace)
+		progress := waitProgress{
+			Workspace:      name,
+			Namespace:      namespace,
+			Phase:          workspacePhase(conditions),
+			Conditions:     conditions,
+			Elapsed:        time.Since(start).Round(time.Second).String(),
+			ElapsedSeconds: int64(time.Since(start).Seconds()),
+		}
+		phaseChanged := progress.Phase != lastPhase
+		lastPhase = progress.Phase
+
+		if output == WaitOutputJSON {
+			if err := writeWaitProgress(out, progress); err != nil {
+				return false, err
+			}
+		} else {
+			// Print the phase when it changes and a dot on every other poll
+			if phaseChanged {
+				if lineOpen {
+					fmt.Fprintln(out)
+				}
+				fmt.Fprintf(out, "  %s (%s elapsed)", progress.Phase, progress.Elapsed)
+			} else {
+				fmt.Fprint(out, ".")
+			}
+			lineOpen = true
+		}
+
+		return progress.Phase == "Ready", nil
+	})
+
+	if lineOpen {
+		fmt.Fprintln(out)
+	}
+
+	if err != nil {
+		if wait.Interrupted(err) && lastPhase == "" && lastErr != nil {
+			return fmt.Errorf("timed out after %s waiting for workspace %s to become ready (last error: %w)", timeout, name, lastErr)
+		}
+		if wait.Interrupted(err) {
+			return fmt.Errorf("timed out after %s waiting for workspace %s to become ready (last phase: %s)", timeout, name, lastPhase)
+		}
+		return err
+	}
+
+	if output == WaitOutputText {
+		fmt.Fprintf(out, "✓ Workspace %s is ready (%s)\n", name, time.Since(start).Round(time.Second))
+	}
+	return nil
+}
+
+// writeWaitProgress writes a progress report to out as a single JSON line
+func writeWaitProgress(out io.Writer, progress waitProgress) error {
+	line, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal wait progress: %w", err)
+	}
+	fmt.Fprintln(out, string(line))
+	return nil
+}
+
+// isPermanentWaitError reports whether a Get error will not clear up by polling again
+func isPermanentWaitError(err error) bool {
+	return errors.IsNotFound(err) || errors.IsForbidden(err) || errors.IsUnauthorized(err) ||
+		errors.IsBadRequest(err) || errors.IsInvalid(err) || errors.IsMethodNotSupported(err)
+}
+
+// workspaceConditions returns the workspace status conditions keyed by type
+func workspaceConditions(workspace *unstructured.Unstructured) map[string]string {
+	conditions := map[string]string{}
+
+	condList, found, err := unstructured.NestedSlice(workspace.Object, "status", "conditions")
+	if err != nil || !found {
+		return conditions
+	}
+
+	for _, condition := range condList {
+		if condMap, ok := condition.(map[string]interface{}); ok {
+			condType, _ := condMap["type"].(string)
+			condStatus, _ := condMap["status"].(string)
+			if condType != "" {
+				conditions[condType] = condStatus
+			}
+		}
+	}
+	return conditions
+}
+
+// workspacePhase summarizes workspace conditions into a single progress phase
+func workspacePhase(conditions map[string]string) string {
+	switch {
+	case conditions[workspaceSucceededCondition] == "True":
+		return "Ready"
+	case len(conditions) == 0:
+		return "Pending"
+	case conditions["ResourceReady"] != "True":
+		return "ProvisioningResources"
+	default:
+		return "DeployingModel"
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2024 Kaito Project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newWorkspaceWithConditions(name string, conditions map[string]string) *unstructured.Unstructured {
+	var condList []interface{}
+	for condType, status := range conditions {
+		condList = append(condList, map[string]interface{}{"type": condType, "status": status})
+	}
+
+	workspace := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"status": map[string]interface{}{"conditions": condList},
+		},
+	}
+	workspace.SetAPIVersion("kaito.sh/v1beta1")
+	workspace.SetKind("Workspace")
+	workspace.SetNamespace("default")
+	workspace.SetName(name)
+	return workspace
+}
+
+func TestWorkspacePhase(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions map[string]string
+		expected   string
+	}{
+		{name: "No conditions", conditions: map[string]string{}, expected: "Pending"},
+		{name: "Resources pending", conditions: map[string]string{"ResourceReady": "False"}, expected: "ProvisioningResources"},
+		{name: "Model deploying", conditions: map[string]string{"ResourceReady": "True", "InferenceReady": "False"}, expected: "DeployingModel"},
+		{name: "Succeeded", conditions: map[string]string{"ResourceReady": "True", "WorkspaceSucceeded": "True"}, expected: "Ready"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, workspacePhase(tt.conditions))
+		})
+	}
+}
+
+func TestValidateWaitOutput(t *testing.T) {
+	assert.NoError(t, validateWaitOutput("text"))
+	assert.NoError(t, validateWaitOutput("json"))
+	assert.Error(t, validateWaitOutput("yaml"))
+}
+
+func TestWaitForWorkspaceReady(t *testing.T) {
+	original := waitPollInterval
+	waitPollInterval = 10 * time.Millisecond
+	defer func() { waitPollInterval = original }()
+
+	t.Run("JSON progress for ready workspace", func(t *testing.T) {
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+			newWorkspaceWithConditions("ready", map[string]string{"ResourceReady": "True", "WorkspaceSucceeded": "True"}))
+
+		var out bytes.Buffer
+		err := waitForWorkspaceReady(context.TODO(), client, "default", "ready", time.Second, WaitOutputJSON, &out)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 1)
+
+		var progress waitProgress
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &progress))
+		assert.Equal(t, "ready", progress.Workspace)
+		assert.Equal(t, "default", progress.Namespace)
+		assert.Equal(t, "Ready", progress.Phase)
+		assert.Equal(t, "True", progress.Conditions["WorkspaceSucceeded"])
+	})
+
+	t.Run("Timeout reports last phase", func(t *testing.T) {
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+			newWorkspaceWithConditions("stuck", map[string]string{"ResourceReady": "False"}))
+
+		var out bytes.Buffer
+		err := waitForWorkspaceReady(context.TODO(), client, "default", "stuck", 50*time.Millisecond, WaitOutputJSON, &out)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timed out")
+		assert.Contains(t, err.Error(), "ProvisioningResources")
+
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			assert.True(t, json.Valid([]byte(line)), "Expected JSON line, got %q", line)
+		}
+	})
+
+	t.Run("Text progress", func(t *testing.T) {
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+			newWorkspaceWithConditions("ready", map[string]string{"ResourceReady": "True", "WorkspaceSucceeded": "True"}))
+
+		var out bytes.Buffer
+		err := waitForWorkspaceReady(context.TODO(), client, "default", "ready", time.Second, WaitOutputText, &out)
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "Waiting for workspace ready")
+		assert.Contains(t, out.String(), "✓ Workspace ready is ready")
+	})
+
+	t.Run("Transient errors keep polling", func(t *testing.T) {
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+			newWorkspaceWithConditions("flaky", map[string]string{"ResourceReady": "True", "WorkspaceSucceeded": "True"}))
+
+		failures := 0
+		client.PrependReactor("get", "workspaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if failures < 2 {
+				failures++
+				return true, nil, apierrors.NewServerTimeout(schema.GroupResource{Group: "kaito.sh", Resource: "workspaces"}, "get", 1)
+			}
+			return false, nil, nil
+		})
+
+		var out bytes.Buffer
+		err := waitForWorkspaceReady(context.TODO(), client, "default", "flaky", time.Second, WaitOutputJSON, &out)
+		require.NoError(t, err)
+		assert.Equal(t, 2, failures)
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 3)
+		for _, line := range lines[:2] {
+			var progress waitProgress
+			require.NoError(t, json.Unmarshal([]byte(line), &progress))
+			assert.Equal(t, "flaky", progress.Workspace)
+			assert.Contains(t, progress.Error, "could not be completed")
+			assert.NotEmpty(t, progress.Elapsed)
+		}
+
+		var last waitProgress
+		require.NoError(t, json.Unmarshal([]byte(lines[2]), &last))
+		assert.Empty(t, last.Error)
+		assert.Equal(t, "Ready", last.Phase)
+	})
+
+	t.Run("Timeout while every poll fails reports the last error", func(t *testing.T) {
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+		client.PrependReactor("get", "workspaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewServiceUnavailable("apiserver restarting")
+		})
+
+		var out bytes.Buffer
+		err := waitForWorkspaceReady(context.TODO(), client, "default", "unreachable", 50*time.Millisecond, WaitOutputText, &out)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timed out")
+		assert.Contains(t, err.Error(), "apiserver restarting")
+	})
+
+	t.Run("Forbidden fails fast", func(t *testing.T) {
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+		client.PrependReactor("get", "workspaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Group: "kaito.sh", Resource: "workspaces"}, "denied", nil)
+		})
+
+		var out bytes.Buffer
+		err := waitForWorkspaceReady(context.TODO(), client, "default", "denied", time.Second, WaitOutputText, &out)
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "timed out")
+	})
+
+	t.Run("Missing workspace fails fast", func(t *testing.T) {
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+		var out bytes.Buffer
+		err := waitForWorkspaceReady(context.TODO(), client, "default", "missing", time.Second, WaitOutputText, &out)
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "timed out")
+	})
+}